@@ -10,15 +10,32 @@ import "C"
 
 import (
 	"errors"
+	"runtime"
+	"sync"
 	"unsafe"
 )
 
+// lastErrorMu serializes native calls made through CallWithLastError, so the
+// process-wide last error read after a call cannot belong to another call.
+var lastErrorMu sync.Mutex
+
+// clearLastError and lastError access the library's last error for
+// CallWithLastError. Tests replace them.
+var (
+	clearLastError = ClearLastError
+	lastError      = GetLastError
+)
+
 // LibraryVersion returns the axidev-io library version string.
 func LibraryVersion() string {
 	return C.GoString(C.axidev_io_library_version())
 }
 
 // GetLastError returns the last error string from the library, if any.
+//
+// The library keeps a single process-wide last error, so when several
+// goroutines use the library concurrently the message may belong to another
+// goroutine's call. Use CallWithLastError to capture errors per call.
 func GetLastError() string {
 	cStr := C.axidev_io_get_last_error()
 	if cStr == nil {
@@ -35,6 +52,7 @@ func ClearLastError() {
 
 // GetLastErrorOrDefault returns the last error or a fallback message as an error.
 // This is useful for wrapping C API calls that may set a global error.
+// It has the same concurrency caveat as GetLastError.
 func GetLastErrorOrDefault(fallback string) error {
 	if errStr := GetLastError(); errStr != "" {
 		return errors.New(errStr)
@@ -42,6 +60,28 @@ func GetLastErrorOrDefault(fallback string) error {
 	return errors.New(fallback)
 }
 
+// CallWithLastError runs call, which wraps a native function reporting
+// failure by returning false, and captures the library's last error for it.
+//
+// The last error is cleared before call and read after it while holding a
+// package-level lock, with the goroutine locked to its OS thread in case the
+// library keeps the error per thread, so the returned message always belongs
+// to this call.
+// Calls made through CallWithLastError are therefore serialized process-wide;
+// call must not call CallWithLastError itself. message is empty when call
+// succeeds or the library recorded no error.
+func CallWithLastError(call func() bool) (ok bool, message string) {
+	lastErrorMu.Lock()
+	defer lastErrorMu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	clearLastError()
+	if call() {
+		return true, ""
+	}
+	return false, lastError()
+}
+
 // freeString is a helper to free C strings.
 func freeString(s *C.char) {
 	C.axidev_io_free_string(s)
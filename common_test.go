@@ -0,0 +1,58 @@
+package axidevio
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// stubLastError replaces the library's last error with a single
+// process-wide slot, as the library keeps it, for the rest of the test.
+func stubLastError(t *testing.T) *string {
+	var slot string
+	prevClear, prevGet := clearLastError, lastError
+	clearLastError = func() { slot = "" }
+	lastError = func() string { return slot }
+	t.Cleanup(func() { clearLastError, lastError = prevClear, prevGet })
+	return &slot
+}
+
+func TestCallWithLastErrorConcurrent(t *testing.T) {
+	slot := stubLastError(t)
+
+	const workers, calls = 8, 200
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range calls {
+				want := fmt.Sprintf("call %d.%d failed", w, i)
+				ok, msg := CallWithLastError(func() bool {
+					*slot = want
+					// Give another failing call the chance to overwrite
+					// the slot before it is read.
+					runtime.Gosched()
+					return false
+				})
+				if ok || msg != want {
+					t.Errorf("CallWithLastError = %v, %q; want false, %q", ok, msg, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCallWithLastErrorSuccess(t *testing.T) {
+	slot := stubLastError(t)
+	*slot = "stale error"
+	if ok, msg := CallWithLastError(func() bool { return true }); !ok || msg != "" {
+		t.Errorf("CallWithLastError = %v, %q; want true, \"\"", ok, msg)
+	}
+	if ok, msg := CallWithLastError(func() bool { return false }); ok || msg != "" {
+		t.Errorf("CallWithLastError with no error recorded = %v, %q; want false, \"\"", ok, msg)
+	}
+}
@@ -7,6 +7,8 @@
 //
 //   - axidevio: Common utilities (logging, errors, version)
 //   - axidevio/keyboard: Keyboard input injection and event monitoring
+//   - axidevio/keyboard/hotkey: Global hotkeys built on keyboard events
+//   - axidevio/keyboard/keyboardtest: In-memory fakes for testing keyboard code
 //
 // # Logging
 //
@@ -23,7 +25,7 @@
 //
 //	sender, _ := keyboard.NewSender()
 //	sender.TypeText("Hello!")
-//	sender.Combo(keyboard.ModCtrl, keyboard.StringToKey("S"))
+//	sender.Combo(keyboard.ModCtrl, keyboard.KeyS)
 //
 // # Runtime Path Configuration
 //
@@ -81,7 +81,7 @@ func main() {
 	// --- Modifier Examples ---
 	fmt.Println("--- Modifiers ---")
 	mods := keyboard.ModCtrl | keyboard.ModShift
-	fmt.Printf("Combined modifiers (Ctrl+Shift): 0x%02X\n", mods)
+	fmt.Printf("Combined modifiers (Ctrl+Shift): %v (0x%02X)\n", mods, uint32(mods))
 	fmt.Printf("  HasCtrl: %v, HasShift: %v, HasAlt: %v\n",
 		mods.HasCtrl(), mods.HasShift(), mods.HasAlt())
 	fmt.Println()
@@ -99,7 +99,7 @@ func main() {
 		}
 
 		// Type a newline
-		if err := sender.Tap(keyboard.StringToKey("Return")); err != nil {
+		if err := sender.Tap(keyboard.KeyEnter); err != nil {
 			fmt.Println("Tap error:", err)
 		}
 		fmt.Println()
@@ -125,8 +125,8 @@ func main() {
 		}
 
 		keyName := event.KeyName()
-		if keyName == "" {
-			keyName = fmt.Sprintf("Unknown(0x%04X)", event.Key)
+		if event.Key == keyboard.KeyUnknown {
+			keyName = fmt.Sprintf("Unknown(U+%04X)", event.Codepoint)
 		}
 
 		// Show modifier state
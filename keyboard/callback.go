@@ -8,21 +8,30 @@ import "C"
 
 import (
 	"runtime/cgo"
+	"time"
 	"unsafe"
 )
 
 //export goKeyboardListenerCallback
 func goKeyboardListenerCallback(codepoint C.uint32_t, key C.uint16_t, mods C.uint8_t, pressed C._Bool, userData unsafe.Pointer) {
-	handle := *(*cgo.Handle)(userData)
-	listener := handle.Value().(*Listener)
+	now := time.Now()
+	run := cgo.Handle(uintptr(userData)).Value().(*listenerRun)
+	run.deliver(KeyEvent{
+		Codepoint: uint32(codepoint),
+		Key:       Key(key),
+		Modifiers: Modifier(mods),
+		Pressed:   bool(pressed),
+		Time:      now,
+	})
+}
 
-	if listener.callback != nil {
-		event := KeyEvent{
-			Codepoint: uint32(codepoint),
-			Key:       Key(key),
-			Modifiers: Modifier(mods),
-			Pressed:   bool(pressed),
-		}
-		listener.callback(event)
+// deliver passes an event from the native listener to the session's
+// callback, unless the session is paused.
+func (run *listenerRun) deliver(event KeyEvent) {
+	run.listener.stamper.stamp(&event)
+	if run.paused.Load() {
+		return
 	}
+	run.listener.invoke(run, event)
 }
+
@@ -0,0 +1,10 @@
+package keyboard
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../include
+*/
+import "C"
+
+// The per-platform linker flags for the native library live in cgolink, so
+// that genkeys links against exactly the same library.
+import _ "github.com/axide-dev/axidev-io-go/keyboard/internal/cgolink"
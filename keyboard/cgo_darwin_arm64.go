@@ -1,9 +0,0 @@
-//go:build darwin && arm64
-
-package keyboard
-
-/*
-#cgo CFLAGS: -I${SRCDIR}/../include
-#cgo LDFLAGS: ${SRCDIR}/../lib/macos-arm64/libaxidev_io.a -lstdc++ -framework ApplicationServices -framework Carbon -framework Foundation -framework CoreGraphics
-*/
-import "C"
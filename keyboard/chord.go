@@ -0,0 +1,31 @@
+package keyboard
+
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+
+// Chord is a key combination: a set of modifiers held while a key is tapped,
+// such as Ctrl+Shift+S.
+type Chord = keytypes.Chord
+
+// ChordStyle selects how Chord.Format renders a chord.
+type ChordStyle = keytypes.ChordStyle
+
+// Chord styles accepted by Chord.Format.
+const (
+	ChordStylePlatform = keytypes.ChordStylePlatform
+	ChordStyleLinux    = keytypes.ChordStyleLinux
+	ChordStyleWindows  = keytypes.ChordStyleWindows
+	ChordStyleMac      = keytypes.ChordStyleMac
+)
+
+// ChordParseError reports why ParseChord rejected its input.
+type ChordParseError = keytypes.ChordParseError
+
+// ParseChord parses an accelerator string such as "Ctrl+Shift+S", "alt+F4" or
+// "⌃⇧S". See keytypes.ParseChord for the accepted syntax.
+//
+// Errors are *ChordParseError values wrapping ErrInvalidChord.
+func ParseChord(s string) (Chord, error) { return keytypes.ParseChord(s) }
+
+// MustParseChord is like ParseChord but panics if s cannot be parsed. It is
+// intended for chords written as constants in source code.
+func MustParseChord(s string) Chord { return keytypes.MustParseChord(s) }
@@ -17,10 +17,83 @@
 //	sender.TypeText("Hello, World!")
 //
 //	// Press a key combination (Ctrl+S)
-//	sender.Combo(keyboard.ModCtrl, keyboard.StringToKey("S"))
+//	sender.Combo(keyboard.ModCtrl, keyboard.KeyS)
 //
 //	// Tap a single key
-//	sender.Tap(keyboard.StringToKey("Enter"))
+//	sender.Tap(keyboard.KeyEnter)
+//
+// Keys are available as constants (KeyA, KeyEnter, KeyF13, KeyNumpad5, ...)
+// generated from the native key table, and AllKeys enumerates them.
+// StringToKey remains available for names that come from user input; it
+// and KeyToString are answered from generated Go tables that mirror the
+// native parser, including its aliases, so they never cross into C. Key
+// methods such as Category, IsModifier, ModifierBit and IsPrintable classify
+// keys; the categories are generated alongside the constants from the
+// native key names, so they follow a key if the native table renumbers it.
+//
+// Shortcuts configured as strings can be parsed into a Chord, sent, and
+// formatted back in the platform's style:
+//
+//	chord, err := keyboard.ParseChord("Ctrl+Shift+S")
+//	if err != nil {
+//	    log.Fatal(err) // e.g. parse chord "Ctrl+Foo": unknown key "Foo" at offset 5
+//	}
+//	sender.SendChord(chord)
+//	fmt.Println(chord.Format(keyboard.ChordStyleMac)) // ⌃⇧S
+//
+// Modifiers also have side-specific bits (ModLeftShift, ModRightAlt, ...).
+// Listener events report them in KeyEvent.Sides and leave Modifiers as the
+// backend reports it, so existing checks such as
+// event.Modifiers == keyboard.ModAlt keep working while
+// event.Sides&keyboard.ModRightAlt tells AltGr from Alt. HoldModifier,
+// ReleaseModifier, Combo and ParseChord accept them too, pressing that
+// side's key ("RightCtrl+S").
+//
+// ModAltGr, ModMeta and ModHyper cover modifiers the platforms have no
+// fixed key for. None has a key by default; SetModifierKeys on a Sender or
+// Listener chooses the key that produces each one, which they then treat
+// like any other modifier:
+//
+//	sender.SetModifierKeys(keyboard.ModifierKeys{Meta: keyboard.KeyAltLeft})
+//	chord, _ := keyboard.ParseChord("Meta+X")
+//	sender.SendChord(chord) // presses left Alt, taps X
+//
+// "Meta" in a chord is ModMeta; the Super, Windows or Command key is
+// "Super".
+//
+// Key and Modifier implement fmt.Stringer and encoding.TextMarshaler, so
+// they print and serialize by name ("Enter", "Ctrl|Shift") rather than as
+// raw integers, and KeyEvent has a stable JSON form built on them.
+//
+// # Key Codes
+//
+// Keys can be translated to and from the codes used by other layers of the
+// input stack:
+//
+//   - Linux evdev (KEY_* from linux/input-event-codes.h): Key.EvdevCode and
+//     KeyFromEvdevCode, for correlating with evtest output or writing udev
+//     hwdb entries.
+//   - USB HID Keyboard/Keypad and Consumer page usages: Key.HIDUsage and
+//     KeyFromHIDUsage, with Modifier.HIDModifiers and ModifierFromHID for the
+//     report's modifier byte.
+//   - Windows virtual-key codes: Key.WindowsVK and KeyFromWindowsVK.
+//   - macOS kVK codes: Key.MacKeyCode and KeyFromMacKeyCode.
+//
+// Key, Modifier, the key constants and these tables are defined in the
+// keytypes subpackage, which does not use cgo. Tools can import it, or build
+// this package with CGO_ENABLED=0 or for another GOOS, to translate bindings
+// for another OS offline without the native library; only Sender, Listener
+// and the types built on them need cgo.
+//
+// Long-running operations have Context variants that can be cancelled
+// between events; keys they pressed are released on cancellation:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+//	defer cancel()
+//	n, err := sender.TypeTextContext(ctx, report)
+//	if errors.Is(err, context.DeadlineExceeded) {
+//	    log.Printf("typed %d characters before the deadline", n)
+//	}
 //
 // # Listener - Global Keyboard Event Monitoring
 //
@@ -38,12 +111,83 @@
 //	    }
 //	})
 //
+// A stopped listener can be started again; Start on a running listener
+// returns ErrAlreadyListening. Pause and Resume suspend delivery without
+// tearing down the native listener. Done is closed when the session ends and
+// Err says why, so a listener that dies on its own (device removed,
+// permissions revoked) can be detected and restarted:
+//
+//	<-listener.Done()
+//	if errors.Is(listener.Err(), keyboard.ErrListenerTerminated) {
+//	    // restart or report
+//	}
+//
+// Events can also be consumed from a buffered channel or an iterator, which
+// keeps slow Go code off the listener thread:
+//
+//	events, err := listener.EventSeq(ctx, keyboard.WithBufferSize(256))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for event := range events {
+//	    fmt.Println(event.KeyName(), event.Pressed)
+//	}
+//
+// Components that each want key events can share one native listener
+// through a Hub, which starts it for the first subscriber and stops it when
+// the last one leaves:
+//
+//	sub, err := keyboard.DefaultHub().Subscribe(func(event keyboard.KeyEvent) {
+//	    // ...
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer sub.Close()
+//
+// If the listener dies, every subscription's Done is closed and its Err says
+// why; the next Subscribe starts a new listener. Closing the hub, for
+// example with defer keyboard.DefaultHub().Close() in main, stops the
+// listener before the program exits.
+//
+// # Interfaces
+//
+// Code that only needs to inject or observe keys can depend on the Injector
+// and EventSource interfaces instead of the concrete types, so alternative
+// backends, decorators and test doubles can be swapped in:
+//
+//	inj, err := keyboard.NewInjector()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer inj.Close()
+//
+// # Errors
+//
+// Failed operations return a *OpError describing the operation, the key or
+// codepoint involved and the native error message. It wraps one of the
+// sentinel errors (ErrSenderClosed, ErrPermission, ErrUnsupported,
+// ErrNoMapping, ...), so callers can branch on the cause:
+//
+//	if err := sender.TypeCharacter('€'); errors.Is(err, keyboard.ErrNoMapping) {
+//	    sender.TypeText("EUR")
+//	}
+//
 // # Thread Safety
 //
 // All Sender and Listener methods are thread-safe. However, the listener callback
 // is invoked from a background thread, so callbacks must be thread-safe and avoid
 // blocking operations.
 //
+// Native calls that can fail are serialized process-wide so that the error
+// each one returns is captured for that call alone; a long TypeText on one
+// Sender therefore delays such calls on other Senders.
+//
+// A panic in a listener callback is recovered instead of unwinding into the
+// native thread. It is reported as a *PanicError through the handler set with
+// Listener.SetErrorHandler and the Listener.Errors channel, and
+// Listener.SetPanicLimit can make the listener stop after repeated panics.
+//
 // # Permissions
 //
 // On some platforms, accessibility or input monitoring permissions may be required.
@@ -0,0 +1,95 @@
+package keyboard
+
+import (
+	"strings"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Sentinel errors reported by Sender and Listener. Failures are returned as
+// *OpError values wrapping one of these, so callers can branch with
+// errors.Is and recover the details with errors.As. They are the keytypes
+// errors, so the fakes in keyboardtest report the same values.
+var (
+	// ErrSenderClosed is returned when a Sender is used after Close.
+	ErrSenderClosed = keytypes.ErrSenderClosed
+
+	// ErrListenerClosed is returned when a Listener is used after Close.
+	ErrListenerClosed = keytypes.ErrListenerClosed
+
+	// ErrAlreadyListening is returned when Listener.Start is called on a
+	// running listener.
+	ErrAlreadyListening = keytypes.ErrAlreadyListening
+
+	// ErrListenerTerminated is reported when the native listener stops on
+	// its own, for example because the device was removed or permissions
+	// were revoked.
+	ErrListenerTerminated = keytypes.ErrListenerTerminated
+
+	// ErrNilCallback is returned when Listener.Start is given a nil callback.
+	ErrNilCallback = keytypes.ErrNilCallback
+
+	// ErrPermission is returned when the backend lacks the permissions or
+	// device access it needs (accessibility, input monitoring, uinput).
+	ErrPermission = keytypes.ErrPermission
+
+	// ErrUnsupported is returned when the active backend does not support
+	// the requested operation.
+	ErrUnsupported = keytypes.ErrUnsupported
+
+	// ErrNoMapping is returned when the backend has no mapping for the
+	// requested key or codepoint in the current layout.
+	ErrNoMapping = keytypes.ErrNoMapping
+
+	// ErrCallbackPanic is wrapped by the *PanicError reported when a
+	// listener callback panics.
+	ErrCallbackPanic = keytypes.ErrCallbackPanic
+
+	// ErrPanicLimit is reported when a Listener stops itself after its
+	// callback panicked too many times in a row (see SetPanicLimit).
+	ErrPanicLimit = keytypes.ErrPanicLimit
+
+	// ErrUnknownKey is returned when a key name cannot be decoded.
+	ErrUnknownKey = keytypes.ErrUnknownKey
+
+	// ErrInvalidChord is wrapped by the *ChordParseError returned when
+	// ParseChord rejects its input.
+	ErrInvalidChord = keytypes.ErrInvalidChord
+
+	// ErrFailed is returned when the backend reports a failure that does not
+	// match a more specific cause.
+	ErrFailed = keytypes.ErrFailed
+)
+
+// OpError describes a failed Sender or Listener operation.
+type OpError = keytypes.OpError
+
+// PanicError reports a panic recovered from a listener callback.
+type PanicError = keytypes.PanicError
+
+// nativeCauses maps fragments of native error messages to sentinel errors.
+// Fragments are matched case-insensitively in order.
+var nativeCauses = []struct {
+	fragment string
+	err      error
+}{
+	{"no mapping", ErrNoMapping},
+	{"permission", ErrPermission},
+	{"privileges", ErrPermission},
+	{"not permitted", ErrPermission},
+	{"/dev/uinput", ErrPermission},
+	{"accessibility", ErrPermission},
+	{"not supported", ErrUnsupported},
+	{"unsupported", ErrUnsupported},
+}
+
+// classify returns the sentinel error matching a native error message.
+func classify(message string) error {
+	lower := strings.ToLower(message)
+	for _, c := range nativeCauses {
+		if strings.Contains(lower, c.fragment) {
+			return c.err
+		}
+	}
+	return ErrFailed
+}
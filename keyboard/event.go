@@ -1,28 +1,107 @@
 package keyboard
 
-// KeyEvent represents a keyboard event received by the listener.
-type KeyEvent struct {
-	// Codepoint is the Unicode codepoint produced by the event (0 if none).
-	Codepoint uint32
+import (
+	"sync"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
 
-	// Key is the logical key ID (0 if unknown).
-	Key Key
+// ListenerCallback is the function signature for keyboard event callbacks.
+// The callback may be invoked from an internal thread and must be thread-safe.
+type ListenerCallback = keytypes.ListenerCallback
 
-	// Modifiers is the current modifier bitmask.
-	Modifiers Modifier
+// KeyEvent represents a keyboard event received by the listener.
+type KeyEvent = keytypes.KeyEvent
 
-	// Pressed is true for key press, false for key release.
-	Pressed bool
+// eventStamper assigns sequence numbers, hold durations and side-specific
+// and extended modifier bits to the events of one listener. extended holds
+// the extended bits of held keys other than modifier keys; those of held
+// modifier keys are derived from sides.
+type eventStamper struct {
+	mu        sync.Mutex
+	seq       uint64
+	pressedAt map[Key]time.Time
+	modKeys   ModifierKeys
+	sides     Modifier
+	extended  Modifier
 }
 
-// IsPress returns true if this is a key press event.
-func (e KeyEvent) IsPress() bool { return e.Pressed }
+// setModifierKeys replaces the extended modifier assignments.
+func (st *eventStamper) setModifierKeys(mk ModifierKeys) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.modKeys = mk
+	st.extended = 0
+}
 
-// IsRelease returns true if this is a key release event.
-func (e KeyEvent) IsRelease() bool { return !e.Pressed }
+// reset forgets the keys held in a previous listening session, whose
+// releases may never arrive. Sequence numbers keep counting.
+func (st *eventStamper) reset() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	clear(st.pressedAt)
+	st.sides = 0
+	st.extended = 0
+}
 
-// Rune returns the Unicode rune for this event, or 0 if none.
-func (e KeyEvent) Rune() rune { return rune(e.Codepoint) }
+// stamp fills event.Seq, event.Sides, the extended bits of event.Modifiers
+// and, for releases, event.HoldDuration.
+func (st *eventStamper) stamp(event *KeyEvent) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.seq++
+	event.Seq = st.seq
+	if event.Key != 0 {
+		st.trackSides(event)
+		st.trackExtended(event)
+	}
+	event.Modifiers, event.Sides = st.modKeys.Apply(event.Modifiers, st.sides)
+	event.Modifiers |= st.extended
+	if event.Key == 0 {
+		return
+	}
+	if event.Pressed {
+		if st.pressedAt == nil {
+			st.pressedAt = make(map[Key]time.Time)
+		}
+		if _, down := st.pressedAt[event.Key]; !down {
+			st.pressedAt[event.Key] = event.Time
+		}
+		return
+	}
+	if t, down := st.pressedAt[event.Key]; down {
+		event.HoldDuration = event.Time.Sub(t)
+		delete(st.pressedAt, event.Key)
+	}
+}
+
+// trackSides updates the held side-specific modifiers for event. Sides
+// whose generic bit the backend no longer reports are dropped, so a missed
+// release cannot leave one stuck.
+func (st *eventStamper) trackSides(event *KeyEvent) {
+	side := event.Key.SideModifierBit()
+	if event.Pressed {
+		st.sides |= side
+	} else {
+		st.sides &^= side
+	}
+	reported := event.Modifiers.Generic()
+	if event.Pressed {
+		reported |= side.Generic()
+	}
+	st.sides &= reported.SideBits()
+}
 
-// KeyName returns the canonical name of the key.
-func (e KeyEvent) KeyName() string { return KeyToString(e.Key) }
+// trackExtended updates the held extended modifiers of keys other than
+// modifier keys for event.
+func (st *eventStamper) trackExtended(event *KeyEvent) {
+	if event.Key.SideModifierBit() != 0 {
+		return
+	}
+	if event.Pressed {
+		st.extended |= st.modKeys.Bits(event.Key)
+	} else {
+		st.extended &^= st.modKeys.Bits(event.Key)
+	}
+}
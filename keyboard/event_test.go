@@ -0,0 +1,99 @@
+package keyboard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStamperReportsSidesSeparately(t *testing.T) {
+	var st eventStamper
+	steps := []struct {
+		event     KeyEvent
+		wantMods  Modifier
+		wantSides Modifier
+	}{
+		{KeyEvent{Key: KeyCtrlRight, Modifiers: ModCtrl, Pressed: true}, ModCtrl, ModRightCtrl},
+		{KeyEvent{Key: KeyShiftLeft, Modifiers: ModCtrl | ModShift, Pressed: true}, ModCtrl | ModShift, ModRightCtrl | ModLeftShift},
+		{KeyEvent{Key: KeyA, Modifiers: ModCtrl | ModShift, Pressed: true}, ModCtrl | ModShift, ModRightCtrl | ModLeftShift},
+		{KeyEvent{Key: KeyShiftLeft, Modifiers: ModCtrl}, ModCtrl, ModRightCtrl},
+		// The backend no longer reports Ctrl: the missed release is dropped.
+		{KeyEvent{Key: KeyA, Pressed: true}, 0, 0},
+	}
+	for i, s := range steps {
+		event := s.event
+		st.stamp(&event)
+		if event.Modifiers != s.wantMods || event.Sides != s.wantSides {
+			t.Errorf("step %d: Modifiers, Sides = %v, %v; want %v, %v", i, event.Modifiers, event.Sides, s.wantMods, s.wantSides)
+		}
+	}
+}
+
+func TestStamperModifierKeys(t *testing.T) {
+	var st eventStamper
+	steps := []struct {
+		event     KeyEvent
+		wantMods  Modifier
+		wantSides Modifier
+	}{
+		// Unassigned by default: right Alt is plain Alt.
+		{KeyEvent{Key: KeyAltRight, Modifiers: ModAlt, Pressed: true}, ModAlt, ModRightAlt},
+		{KeyEvent{Key: KeyAltRight, Modifiers: 0}, 0, 0},
+	}
+	for i, s := range steps {
+		event := s.event
+		st.stamp(&event)
+		if event.Modifiers != s.wantMods || event.Sides != s.wantSides {
+			t.Errorf("default step %d: Modifiers, Sides = %v, %v; want %v, %v", i, event.Modifiers, event.Sides, s.wantMods, s.wantSides)
+		}
+	}
+
+	st.setModifierKeys(ModifierKeys{AltGr: KeyAltRight, Hyper: KeyF13})
+	steps = []struct {
+		event     KeyEvent
+		wantMods  Modifier
+		wantSides Modifier
+	}{
+		{KeyEvent{Key: KeyAltRight, Modifiers: ModAlt, Pressed: true}, ModAltGr, 0},
+		{KeyEvent{Key: KeyE, Modifiers: ModAlt, Pressed: true}, ModAltGr, 0},
+		// With the left Alt held too, Alt stays.
+		{KeyEvent{Key: KeyAltLeft, Modifiers: ModAlt, Pressed: true}, ModAlt | ModAltGr, ModLeftAlt},
+		{KeyEvent{Key: KeyAltLeft, Modifiers: ModAlt}, ModAltGr, 0},
+		{KeyEvent{Key: KeyAltRight, Modifiers: 0}, 0, 0},
+		{KeyEvent{Key: KeyF13, Pressed: true}, ModHyper, 0},
+		{KeyEvent{Key: KeyX, Pressed: true}, ModHyper, 0},
+		{KeyEvent{Key: KeyF13}, 0, 0},
+	}
+	for i, s := range steps {
+		event := s.event
+		st.stamp(&event)
+		if event.Modifiers != s.wantMods || event.Sides != s.wantSides {
+			t.Errorf("step %d: Modifiers, Sides = %v, %v; want %v, %v", i, event.Modifiers, event.Sides, s.wantMods, s.wantSides)
+		}
+	}
+}
+
+func TestStamperReset(t *testing.T) {
+	var st eventStamper
+	start := time.Unix(0, 0)
+	press := KeyEvent{Key: KeyShiftLeft, Modifiers: ModShift, Pressed: true, Time: start}
+	st.stamp(&press)
+
+	// The release is lost when the session stops; the next session must not
+	// measure the hold from the old press or report the side as held.
+	st.reset()
+	event := KeyEvent{Key: KeyA, Pressed: true, Time: start.Add(time.Minute)}
+	st.stamp(&event)
+	if event.Sides != 0 {
+		t.Errorf("Sides = %v after reset, want 0", event.Sides)
+	}
+	press = KeyEvent{Key: KeyShiftLeft, Modifiers: ModShift, Pressed: true, Time: start.Add(time.Hour)}
+	st.stamp(&press)
+	release := KeyEvent{Key: KeyShiftLeft, Time: start.Add(time.Hour + time.Second)}
+	st.stamp(&release)
+	if release.HoldDuration != time.Second {
+		t.Errorf("HoldDuration = %v, want 1s", release.HoldDuration)
+	}
+	if release.Seq != 4 {
+		t.Errorf("Seq = %d, want 4: sequence numbers continue across sessions", release.Seq)
+	}
+}
@@ -0,0 +1,241 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultEventBuffer is the channel capacity used by Events when no buffer
+// size is given.
+const DefaultEventBuffer = 64
+
+// OverflowPolicy selects what happens when an event arrives while the
+// consumer's buffer is full.
+type OverflowPolicy uint8
+
+const (
+	// OverflowDropOldest discards the oldest buffered event to make room.
+	// It is the default: the listener thread never waits and the consumer
+	// always sees the most recent events.
+	OverflowDropOldest OverflowPolicy = iota
+
+	// OverflowDropNewest discards the incoming event.
+	OverflowDropNewest
+
+	// OverflowBlock makes the listener thread wait until the consumer reads
+	// or the stream is cancelled. No events are lost, but a slow consumer
+	// stalls delivery of every later event.
+	OverflowBlock
+)
+
+// EventsOption configures an event stream created by Events.
+type EventsOption func(*eventsConfig)
+
+type eventsConfig struct {
+	buffer  int
+	policy  OverflowPolicy
+	dropped *atomic.Uint64
+}
+
+// WithBufferSize sets the channel capacity. Values below 1 are treated as 1.
+func WithBufferSize(n int) EventsOption {
+	return func(c *eventsConfig) { c.buffer = max(n, 1) }
+}
+
+// WithOverflowPolicy sets the policy applied when the buffer is full.
+func WithOverflowPolicy(p OverflowPolicy) EventsOption {
+	return func(c *eventsConfig) { c.policy = p }
+}
+
+// WithDropCounter makes the stream add the number of discarded events to
+// counter.
+func WithDropCounter(counter *atomic.Uint64) EventsOption {
+	return func(c *eventsConfig) { c.dropped = counter }
+}
+
+func newEventsConfig(opts []EventsOption) eventsConfig {
+	c := eventsConfig{buffer: DefaultEventBuffer}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// eventQueue delivers events from a listener thread to a buffered channel
+// according to an overflow policy.
+type eventQueue struct {
+	ch      chan KeyEvent
+	policy  OverflowPolicy
+	done    chan struct{}
+	dropped []*atomic.Uint64
+
+	unblockOnce sync.Once
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func newEventQueue(c eventsConfig, counters ...*atomic.Uint64) *eventQueue {
+	q := &eventQueue{
+		ch:     make(chan KeyEvent, c.buffer),
+		policy: c.policy,
+		done:   make(chan struct{}),
+	}
+	for _, ctr := range append(counters, c.dropped) {
+		if ctr != nil {
+			q.dropped = append(q.dropped, ctr)
+		}
+	}
+	return q
+}
+
+// push delivers event. It must not be called concurrently with itself;
+// events pushed after close are discarded.
+func (q *eventQueue) push(event KeyEvent) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return
+	}
+	switch q.policy {
+	case OverflowBlock:
+		select {
+		case q.ch <- event:
+		case <-q.done:
+		}
+	case OverflowDropNewest:
+		select {
+		case q.ch <- event:
+		default:
+			q.drop()
+		}
+	default:
+		for {
+			select {
+			case q.ch <- event:
+				return
+			default:
+			}
+			select {
+			case <-q.ch:
+				q.drop()
+			default:
+			}
+		}
+	}
+}
+
+func (q *eventQueue) drop() {
+	for _, ctr := range q.dropped {
+		ctr.Add(1)
+	}
+}
+
+// unblock makes a pending or later push stop waiting for the consumer. Safe
+// to call multiple times.
+func (q *eventQueue) unblock() {
+	q.unblockOnce.Do(func() { close(q.done) })
+}
+
+// close unblocks a pending push, then closes the channel once no push is in
+// progress. stop is called in between to stop the producer.
+func (q *eventQueue) close(stop func()) {
+	q.unblock()
+	stop()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	close(q.ch)
+}
+
+// Events starts src and returns a channel that receives its events.
+//
+// Events are buffered (DefaultEventBuffer unless WithBufferSize is given)
+// and the overflow policy decides what happens when the consumer falls
+// behind, so a slow consumer never stalls the listener thread unless
+// OverflowBlock is chosen. When ctx is done, src is stopped and the channel
+// is closed. If src has a Done method, as Listener does, the channel is also
+// closed when the source stops on its own.
+func Events(ctx context.Context, src EventSource, opts ...EventsOption) (<-chan KeyEvent, error) {
+	return startEvents(ctx, src, newEventsConfig(opts))
+}
+
+func startEvents(ctx context.Context, src EventSource, c eventsConfig, counters ...*atomic.Uint64) (<-chan KeyEvent, error) {
+	q := newEventQueue(c, counters...)
+	if err := src.Start(q.push); err != nil {
+		return nil, err
+	}
+	// With a Done method, the run started here is identified by its Done
+	// channel, so that a run that has ended is not stopped on behalf of
+	// whoever started src again since.
+	d, _ := src.(interface{ Done() <-chan struct{} })
+	var srcDone <-chan struct{}
+	if d != nil {
+		srcDone = d.Done()
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-srcDone:
+		}
+		q.close(func() {
+			if d == nil || d.Done() == srcDone {
+				src.Stop()
+			}
+		})
+	}()
+	return q.ch, nil
+}
+
+// EventSeq is like Events but returns an iterator over the events. Breaking
+// out of the loop stops src; if the iterator is never used, src runs until
+// ctx is done.
+func EventSeq(ctx context.Context, src EventSource, opts ...EventsOption) (iter.Seq[KeyEvent], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch, err := Events(ctx, src, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return seqOf(ch, cancel), nil
+}
+
+func seqOf(ch <-chan KeyEvent, cancel context.CancelFunc) iter.Seq[KeyEvent] {
+	return func(yield func(KeyEvent) bool) {
+		defer cancel()
+		for event := range ch {
+			if !yield(event) {
+				return
+			}
+		}
+	}
+}
+
+// Events starts the listener and returns a channel that receives its
+// events. See the package-level Events for buffering and overflow
+// behaviour. Discarded events are counted by DroppedEvents.
+func (l *Listener) Events(ctx context.Context, opts ...EventsOption) (<-chan KeyEvent, error) {
+	return startEvents(ctx, l, newEventsConfig(opts), &l.dropped)
+}
+
+// EventSeq is like Events but returns an iterator over the events. Breaking
+// out of the loop stops the listener.
+func (l *Listener) EventSeq(ctx context.Context, opts ...EventsOption) (iter.Seq[KeyEvent], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch, err := l.Events(ctx, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return seqOf(ch, cancel), nil
+}
+
+// DroppedEvents returns the number of events discarded by the overflow
+// policy of streams created with Listener.Events or Listener.EventSeq.
+func (l *Listener) DroppedEvents() uint64 {
+	return l.dropped.Load()
+}
@@ -0,0 +1,48 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keyboardtest"
+)
+
+func TestEventsDoesNotStopLaterRun(t *testing.T) {
+	l := keyboardtest.NewListener()
+	ch, err := Events(context.Background(), l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Stop()
+	if err := l.Start(func(KeyEvent) {}); err != nil {
+		t.Fatal(err)
+	}
+	for range ch {
+	}
+	if !l.IsListening() {
+		t.Error("the run started after the stream's run ended was stopped")
+	}
+}
+
+func TestEventsStopsOwnRun(t *testing.T) {
+	l := keyboardtest.NewListener()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := Events(ctx, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Tap(KeyA)
+	cancel()
+	var got []KeyEvent
+	for event := range ch {
+		got = append(got, event)
+	}
+	if l.IsListening() {
+		t.Error("listener still running after ctx was cancelled")
+	}
+	if len(got) > 2 {
+		t.Errorf("got %d events, want at most 2", len(got))
+	}
+}
@@ -0,0 +1,119 @@
+package hotkey
+
+import (
+	"slices"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Binding is a chord or sequence registered with a Manager.
+type Binding struct {
+	manager   *Manager
+	layer     *Layer
+	seq       Sequence
+	handler   Handler
+	onRelease bool
+	timeout   time.Duration
+	ownTime   bool
+
+	owner          string
+	description    string
+	allowPrefix    bool
+	allowDuplicate bool
+
+	// action, if set, runs under manager.mu when the binding fires, before
+	// the handler is queued. Layer activation bindings use it.
+	action func(keytypes.KeyEvent)
+
+	// registered is guarded by manager.mu.
+	registered bool
+}
+
+// Option configures a binding at registration.
+type Option func(*Binding)
+
+// OnRelease makes the binding fire when its last key is released rather
+// than when it is pressed. The chord must match when the key goes down;
+// the modifiers may be released first. With AllowPrefix, a release that
+// comes while the Manager still waits for a longer sequence fires it once
+// the wait ends.
+func OnRelease() Option {
+	return func(b *Binding) { b.onRelease = true }
+}
+
+// WithTimeout sets how long the binding allows between the steps of its
+// sequence, overriding the Manager's timeout. As with SetTimeout, zero
+// waits indefinitely; a binding registered without WithTimeout follows the
+// Manager's. It has no effect on a single chord.
+func WithTimeout(d time.Duration) Option {
+	return func(b *Binding) { b.timeout, b.ownTime = max(d, 0), true }
+}
+
+// WithOwner records who registered the binding, such as a feature or
+// plugin name, for Bindings and conflict errors.
+func WithOwner(owner string) Option {
+	return func(b *Binding) { b.owner = owner }
+}
+
+// WithDescription records what the binding does, for Bindings and conflict
+// errors.
+func WithDescription(description string) Option {
+	return func(b *Binding) { b.description = description }
+}
+
+// AllowPrefix lets the binding's sequence be a prefix of another binding's,
+// or extend one, when the other binding allows it too. The Manager then
+// waits after the shorter sequence as described for RegisterSequence.
+func AllowPrefix() Option {
+	return func(b *Binding) { b.allowPrefix = true }
+}
+
+// AllowDuplicate lets the binding share its sequence with other bindings
+// that allow it too. They fire in registration order.
+func AllowDuplicate() Option {
+	return func(b *Binding) { b.allowDuplicate = true }
+}
+
+// Chord returns the last chord of the binding's sequence, which is the
+// whole binding for a single chord.
+func (b *Binding) Chord() keytypes.Chord { return b.seq[len(b.seq)-1] }
+
+// Sequence returns the chords the binding matches, in order.
+func (b *Binding) Sequence() Sequence { return slices.Clone(b.seq) }
+
+// Layer returns the layer the binding belongs to.
+func (b *Binding) Layer() *Layer { return b.layer }
+
+// Owner returns the owner set with WithOwner.
+func (b *Binding) Owner() string { return b.owner }
+
+// Description returns the description set with WithDescription.
+func (b *Binding) Description() string { return b.description }
+
+// OnRelease returns true if the binding fires on release.
+func (b *Binding) OnRelease() bool { return b.onRelease }
+
+// Registered returns true until the binding is unregistered or its Manager
+// is closed.
+func (b *Binding) Registered() bool {
+	b.manager.mu.Lock()
+	defer b.manager.mu.Unlock()
+	return b.registered
+}
+
+// Unregister removes the binding. A handler already triggered but not yet
+// started is skipped. Safe to call multiple times, including from the
+// binding's own handler.
+func (b *Binding) Unregister() {
+	b.manager.unregister(b)
+}
+
+// timeoutOr returns the binding's timeout, or def if it has none of its
+// own.
+func (b *Binding) timeoutOr(def time.Duration) time.Duration {
+	if b.ownTime {
+		return b.timeout
+	}
+	return def
+}
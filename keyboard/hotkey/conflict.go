@@ -0,0 +1,110 @@
+package hotkey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrConflict is wrapped by the *ConflictError returned when a binding
+// would clash with one already registered.
+var ErrConflict = errors.New("hotkey conflict")
+
+// ConflictKind describes how two sequences clash.
+type ConflictKind uint8
+
+const (
+	// ConflictExact means both sequences match the same keystrokes.
+	ConflictExact ConflictKind = iota + 1
+
+	// ConflictPrefix means the new sequence is a prefix of the existing
+	// one, so the existing one is delayed by a timeout whenever the new
+	// one is typed.
+	ConflictPrefix
+
+	// ConflictShadowed means the existing sequence is a prefix of the new
+	// one.
+	ConflictShadowed
+)
+
+var conflictKindNames = [...]string{
+	ConflictExact:    "same sequence",
+	ConflictPrefix:   "prefix of",
+	ConflictShadowed: "extends",
+}
+
+// String describes the kind, such as "prefix of".
+func (k ConflictKind) String() string {
+	if int(k) < len(conflictKindNames) && conflictKindNames[k] != "" {
+		return conflictKindNames[k]
+	}
+	return fmt.Sprintf("ConflictKind(%d)", uint8(k))
+}
+
+// ConflictError reports a registration rejected because of an existing
+// binding.
+type ConflictError struct {
+	// Sequence is the sequence being registered.
+	Sequence Sequence
+
+	// Kind is how it clashes with Existing.
+	Kind ConflictKind
+
+	// Existing is the registered binding it clashes with.
+	Existing *Binding
+}
+
+// Error describes the conflict, naming the existing binding's owner and
+// description when it has them.
+func (e *ConflictError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "register %q: %v: %s %q", e.Sequence.String(), ErrConflict, e.Kind, e.Existing.seq.String())
+	if e.Existing.owner != "" {
+		fmt.Fprintf(&b, " owned by %s", e.Existing.owner)
+	}
+	if e.Existing.description != "" {
+		fmt.Fprintf(&b, " (%s)", e.Existing.description)
+	}
+	return b.String()
+}
+
+// Unwrap returns ErrConflict.
+func (e *ConflictError) Unwrap() error { return ErrConflict }
+
+// conflict returns how seq clashes with existing, or 0 if it does not.
+func conflict(seq, existing Sequence) ConflictKind {
+	n := min(len(seq), len(existing))
+	for i := range n {
+		if !overlaps(seq[i], existing[i]) {
+			return 0
+		}
+	}
+	switch {
+	case len(seq) == len(existing):
+		return ConflictExact
+	case len(seq) < len(existing):
+		return ConflictPrefix
+	default:
+		return ConflictShadowed
+	}
+}
+
+// allows reports whether a and b both accept a conflict of kind.
+func allows(a, b *Binding, kind ConflictKind) bool {
+	if kind == ConflictExact {
+		return a.allowDuplicate && b.allowDuplicate
+	}
+	return a.allowPrefix && b.allowPrefix
+}
+
+// conflictLocked returns a *ConflictError if b clashes with a binding
+// registered in its layer that does not accept it. Bindings in different
+// layers never conflict. m.mu must be held.
+func (m *Manager) conflictLocked(b *Binding) error {
+	for _, x := range b.layer.bindings {
+		if kind := conflict(b.seq, x.seq); kind != 0 && !allows(b, x, kind) {
+			return &ConflictError{Sequence: b.Sequence(), Kind: kind, Existing: x}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package hotkey
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+func TestConflicts(t *testing.T) {
+	tests := []struct {
+		existing string
+		oldOpts  []Option
+		seq      string
+		newOpts  []Option
+		want     ConflictKind
+	}{
+		{"Ctrl+S", nil, "Ctrl+S", nil, ConflictExact},
+		{"Ctrl+S", nil, "ctrl+s", nil, ConflictExact},
+		{"Ctrl+S", nil, "LeftCtrl+S", nil, ConflictExact},
+		{"Ctrl+S", nil, "Ctrl+Shift+S", nil, 0},
+		{"Ctrl+S", nil, "Ctrl+D", nil, 0},
+		{"Ctrl+S", []Option{AllowDuplicate()}, "Ctrl+S", []Option{AllowDuplicate()}, 0},
+		{"Ctrl+S", []Option{AllowDuplicate()}, "Ctrl+S", nil, ConflictExact},
+		{"Ctrl+S", []Option{AllowPrefix()}, "Ctrl+S", []Option{AllowPrefix()}, ConflictExact},
+		{"Ctrl+X Ctrl+S", nil, "Ctrl+X", nil, ConflictPrefix},
+		{"Ctrl+X Ctrl+S", nil, "Ctrl+X Ctrl+S Ctrl+C", nil, ConflictShadowed},
+		{"Ctrl+X", nil, "Ctrl+X Ctrl+S", nil, ConflictShadowed},
+		{"Ctrl+X", []Option{AllowPrefix()}, "Ctrl+X Ctrl+S", []Option{AllowPrefix()}, 0},
+		{"Ctrl+X", []Option{AllowPrefix()}, "Ctrl+X Ctrl+S", nil, ConflictShadowed},
+		{"G G", []Option{AllowPrefix()}, "G", []Option{AllowPrefix()}, 0},
+		{"Ctrl+X Ctrl+S", nil, "Ctrl+C Ctrl+S", nil, 0},
+	}
+	for _, tt := range tests {
+		m := New(nil)
+		old, err := m.Register(tt.existing, func(Event) {}, append(tt.oldOpts, WithOwner("editor"))...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = m.Register(tt.seq, func(Event) {}, tt.newOpts...)
+		var ce *ConflictError
+		switch {
+		case tt.want == 0 && err != nil:
+			t.Errorf("%q then %q: %v, want no conflict", tt.existing, tt.seq, err)
+		case tt.want == 0:
+		case !errors.As(err, &ce) || !errors.Is(err, ErrConflict):
+			t.Errorf("%q then %q: %v, want a *ConflictError", tt.existing, tt.seq, err)
+		case ce.Kind != tt.want || ce.Existing != old:
+			t.Errorf("%q then %q: Kind, Existing = %v, %q; want %v, %q", tt.existing, tt.seq, ce.Kind, ce.Existing.Sequence(), tt.want, old.Sequence())
+		}
+		m.Close()
+	}
+}
+
+func TestConflictsArePerLayer(t *testing.T) {
+	m := New(nil)
+	defer m.Close()
+	launcher, err := m.NewLayer("launcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Register("Ctrl+S", func(Event) {}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := launcher.Register("Ctrl+S", func(Event) {}); err != nil {
+		t.Errorf("same chord in another layer: %v", err)
+	}
+	if got := len(m.Lookup(mustParseSequence(t, "Ctrl+S"))); got != 2 {
+		t.Errorf("Lookup found %d bindings, want 2", got)
+	}
+}
+
+func TestBindingIntrospection(t *testing.T) {
+	m, l := startManager(t)
+	var r recorder
+	for _, name := range []string{"first", "second"} {
+		_, err := m.Register("Ctrl+S", r.handler(name), AllowDuplicate(), WithOwner(name), WithDescription("save"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	var owners []string
+	for _, b := range m.Bindings() {
+		if b.Description() != "save" || b.Layer() != m.Base() {
+			t.Errorf("binding %q: Description, Layer = %q, %v", b.Sequence(), b.Description(), b.Layer().Name())
+		}
+		owners = append(owners, b.Owner())
+	}
+	if !slices.Equal(owners, []string{"first", "second"}) {
+		t.Errorf("owners = %v, want [first second]", owners)
+	}
+	l.Combo(keytypes.ModCtrl, keytypes.KeyS)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"first", "second"}) {
+		t.Errorf("duplicates fired %v, want [first second] in order", got)
+	}
+}
+
+// mustParseSequence parses s or fails the test.
+func mustParseSequence(t *testing.T, s string) Sequence {
+	t.Helper()
+	seq, err := ParseSequence(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return seq
+}
@@ -0,0 +1,107 @@
+// Package hotkey calls Go functions when global keyboard shortcuts,
+// sequences or gestures are performed.
+//
+// A Manager watches a keyboard.EventSource and matches its events against
+// registered chords:
+//
+//	listener, err := keyboard.NewListener()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer listener.Close()
+//
+//	hk := hotkey.New(listener)
+//	defer hk.Close()
+//
+//	hk.Register("Ctrl+Alt+T", func(hotkey.Event) {
+//	    exec.Command("x-terminal-emulator").Start()
+//	})
+//	if err := hk.Start(); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// A chord fires once when its key is pressed while exactly its modifiers
+// are held; key repeat while the key stays down does not fire it again.
+// OnRelease makes it fire when the key is released instead. Lock modifiers
+// (CapsLock, NumLock) are ignored, and side-specific or extended modifiers
+// in a chord ("RightCtrl+K", "Hyper+K") must be held as given.
+//
+// Register also accepts Emacs- and Vim-style sequences of chords separated
+// by spaces. Modifier presses between the steps are ignored, and a
+// sequence is abandoned when a step does not follow within the Manager's
+// timeout (SetTimeout) or the binding's own (WithTimeout). Where one
+// sequence is a prefix of another, the shorter fires only once it is clear
+// the longer is not being typed. SetPendingHandler reports the prefix typed
+// so far:
+//
+//	hk.Register("Ctrl+X Ctrl+S", save)
+//	hk.Register("G", next, hotkey.AllowPrefix())
+//	hk.Register("G G", top, hotkey.AllowPrefix())
+//	hk.SetPendingHandler(func(prefix hotkey.Sequence) {
+//	    status.Show(prefix.String() + "-") // "Ctrl+X-", or "-" when done
+//	})
+//
+// Registering a sequence that clashes with an existing binding fails with
+// a *ConflictError naming it, unless both bindings opt in with
+// AllowDuplicate or AllowPrefix. WithOwner and WithDescription label
+// bindings for these errors and for Bindings, which lists what is
+// registered:
+//
+//	_, err := hk.Register("Ctrl+S", save, hotkey.WithOwner("editor"))
+//	if errors.Is(err, hotkey.ErrConflict) {
+//	    log.Print(err) // register "Ctrl+S": hotkey conflict: same sequence "Ctrl+S" owned by sync
+//	}
+//	for _, b := range hk.Bindings() {
+//	    fmt.Println(b.Sequence(), b.Owner(), b.Description())
+//	}
+//
+// Bindings live in layers. The Manager's Register methods add to the base
+// layer, BaseLayer ("normal"), which is always active; NewLayer creates
+// others, each with its own bindings and conflict checks. A layer is
+// activated by a leader chord for one action, by a momentary key while it
+// is held, by a toggle, or by Enter, and sits on a stack above the base
+// layer. Keys are matched against the top layer first, and go on to the
+// layers below only if it was created with FallThrough. OnEnter and OnExit
+// hooks run as a layer comes and goes, and CurrentMode names the top one:
+//
+//	launcher, _ := hk.NewLayer("launcher", hotkey.OnEnter(showMenu), hotkey.OnExit(hideMenu))
+//	launcher.Register("T", openTerminal)
+//	hk.Base().BindLeader("Super+Space", launcher)
+//
+//	nav, _ := hk.NewLayer("nav", hotkey.FallThrough())
+//	nav.Register("H", left)
+//	hk.Base().BindMomentary("CapsLock", nav)
+//
+//	insert, _ := hk.NewLayer("insert")
+//	hk.Base().BindToggle("I", insert)
+//	insert.BindToggle("Escape", insert)
+//
+//	fmt.Println(hk.CurrentMode()) // "normal", "launcher", "nav" or "insert"
+//
+// A Recognizer tells taps, double taps, holds and long presses of chosen
+// keys apart, with a configurable tapping term, hold threshold and
+// double-tap window, and an InterruptPolicy for presses interrupted by
+// other keys:
+//
+//	gestures := hotkey.NewRecognizer(hotkey.GestureConfig{
+//	    Interrupt: hotkey.InterruptPermissiveHold,
+//	}, func(g hotkey.Gesture) {
+//	    switch g.Kind {
+//	    case hotkey.GestureTap:
+//	        sender.Tap(keyboard.KeyEscape)
+//	    case hotkey.GestureHold:
+//	        sender.HoldModifier(keyboard.ModCtrl)
+//	    case hotkey.GestureRelease:
+//	        sender.ReleaseModifier(keyboard.ModCtrl)
+//	    }
+//	})
+//	gestures.Watch(keyboard.KeyCapsLock)
+//	sub, err := keyboard.DefaultHub().Subscribe(gestures.HandleEvent)
+//
+// Handlers run one at a time, in event order, on a goroutine owned by the
+// Manager or Recognizer, never on the listener's thread, so they may block
+// or call back into it. A Manager can also be fed from a keyboard.Hub or any
+// other event stream through HandleEvent:
+//
+//	sub, err := keyboard.DefaultHub().Subscribe(hk.HandleEvent)
+package hotkey
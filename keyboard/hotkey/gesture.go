@@ -0,0 +1,446 @@
+package hotkey
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Default gesture timings, used where a GestureConfig field is zero.
+const (
+	DefaultTappingTerm     = 200 * time.Millisecond
+	DefaultDoubleTapWindow = 200 * time.Millisecond
+	DefaultLongPress       = 800 * time.Millisecond
+)
+
+// GestureKind identifies a recognized gesture.
+type GestureKind uint8
+
+const (
+	// GestureTap is a press released within the tapping term. When double
+	// taps are enabled it is reported once the double-tap window passes
+	// without a second tap.
+	GestureTap GestureKind = iota + 1
+
+	// GestureDoubleTap is two taps, the second pressed within the
+	// double-tap window of the first's release.
+	GestureDoubleTap
+
+	// GestureHold is reported once a press lasts past the hold threshold,
+	// or earlier when another key interrupts it (see InterruptPolicy).
+	GestureHold
+
+	// GestureLongPress is reported once a held key lasts past the
+	// long-press threshold. It follows GestureHold.
+	GestureLongPress
+
+	// GestureRelease is reported when a key recognized as held is
+	// released.
+	GestureRelease
+)
+
+var gestureKindNames = [...]string{
+	GestureTap:       "Tap",
+	GestureDoubleTap: "DoubleTap",
+	GestureHold:      "Hold",
+	GestureLongPress: "LongPress",
+	GestureRelease:   "Release",
+}
+
+// String returns the kind's name, such as "DoubleTap".
+func (k GestureKind) String() string {
+	if int(k) < len(gestureKindNames) && gestureKindNames[k] != "" {
+		return gestureKindNames[k]
+	}
+	return "GestureKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// InterruptPolicy decides how a press that is still undecided between tap
+// and hold is resolved when another key is pressed during it.
+type InterruptPolicy uint8
+
+const (
+	// InterruptNone ignores other keys: only the press duration decides.
+	InterruptNone InterruptPolicy = iota
+
+	// InterruptHoldOnOtherKey resolves the press as a hold as soon as
+	// another key goes down, which suits keys used as modifiers.
+	InterruptHoldOnOtherKey
+
+	// InterruptPermissiveHold resolves the press as a hold when another
+	// key is pressed and released while it is down, so a fast roll (the
+	// other key released after this one) still counts as a tap.
+	InterruptPermissiveHold
+)
+
+// GestureConfig sets the timings and interrupt policy for a watched key.
+// Zero durations take the defaults above; a negative DoubleTapWindow or
+// LongPress disables that gesture.
+type GestureConfig struct {
+	// TappingTerm is the longest a press may last and count as a tap.
+	TappingTerm time.Duration
+
+	// HoldThreshold is how long a press must last to count as a hold. It
+	// defaults to, and is never less than, TappingTerm; a press released
+	// between the two is neither.
+	HoldThreshold time.Duration
+
+	// DoubleTapWindow is the longest gap between a tap's release and the
+	// next press for the two to form a double tap. Enabling it delays
+	// GestureTap by up to the window.
+	DoubleTapWindow time.Duration
+
+	// LongPress is how long a press must last to count as a long press.
+	LongPress time.Duration
+
+	// Interrupt resolves undecided presses interrupted by other keys.
+	Interrupt InterruptPolicy
+}
+
+// withDefaults returns c with zero fields replaced by their defaults.
+func (c GestureConfig) withDefaults() GestureConfig {
+	if c.TappingTerm <= 0 {
+		c.TappingTerm = DefaultTappingTerm
+	}
+	c.HoldThreshold = max(c.HoldThreshold, c.TappingTerm)
+	if c.DoubleTapWindow == 0 {
+		c.DoubleTapWindow = DefaultDoubleTapWindow
+	}
+	if c.LongPress == 0 {
+		c.LongPress = DefaultLongPress
+	}
+	return c
+}
+
+// Gesture is a recognized gesture on a watched key.
+type Gesture struct {
+	// Kind is what was recognized.
+	Kind GestureKind
+
+	// Key is the watched key.
+	Key keytypes.Key
+
+	// Modifiers are the modifiers held when the gesture's first press
+	// happened.
+	Modifiers keytypes.Modifier
+
+	// Time is when the gesture was recognized.
+	Time time.Time
+
+	// Duration is how long the gesture took: the press for a tap, from the
+	// first press to the second release for a double tap, and how long the
+	// key had been held for the others.
+	Duration time.Duration
+}
+
+// Recognizer turns the key events of watched keys into gestures. Feed it
+// with HandleEvent, for example as a Listener callback or Hub subscriber.
+// Other keys are only used to detect interruptions. All methods are safe
+// for concurrent use.
+type Recognizer struct {
+	handler  func(Gesture)
+	dispatch dispatcher
+
+	mu     sync.Mutex
+	config GestureConfig
+	keys   map[keytypes.Key]*gestureState
+	closed bool
+}
+
+// NewRecognizer creates a Recognizer that applies config to keys passed to
+// Watch and reports gestures to handler. handler runs one gesture at a
+// time, in order, on a goroutine owned by the Recognizer.
+func NewRecognizer(config GestureConfig, handler func(Gesture)) *Recognizer {
+	return &Recognizer{
+		handler: handler,
+		config:  config.withDefaults(),
+		keys:    make(map[keytypes.Key]*gestureState),
+	}
+}
+
+// SetErrorHandler sets a function called with a *keytypes.PanicError when
+// the gesture handler panics. The panic is recovered and later gestures are
+// still reported. handler runs on the handler goroutine; panics in it are
+// discarded. A nil handler removes it.
+func (r *Recognizer) SetErrorHandler(handler func(error)) {
+	r.dispatch.setErrorHandler(handler)
+}
+
+// Watch starts recognizing gestures on keys with the Recognizer's config.
+func (r *Recognizer) Watch(keys ...keytypes.Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, k := range keys {
+		r.watchLocked(k, r.config)
+	}
+}
+
+// WatchConfig starts recognizing gestures on key with its own config.
+func (r *Recognizer) WatchConfig(key keytypes.Key, config GestureConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watchLocked(key, config.withDefaults())
+}
+
+func (r *Recognizer) watchLocked(key keytypes.Key, config GestureConfig) {
+	if st := r.keys[key]; st != nil {
+		st.reset()
+	}
+	r.keys[key] = &gestureState{r: r, key: key, config: config}
+}
+
+// Unwatch stops recognizing gestures on keys, dropping any in progress.
+func (r *Recognizer) Unwatch(keys ...keytypes.Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, k := range keys {
+		if st := r.keys[k]; st != nil {
+			st.reset()
+			delete(r.keys, k)
+		}
+	}
+}
+
+// Close stops the Recognizer. Gestures in progress are dropped and later
+// events are ignored.
+func (r *Recognizer) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	for _, st := range r.keys {
+		st.reset()
+	}
+}
+
+// HandleEvent feeds a key event to the Recognizer. It never blocks on the
+// handler.
+func (r *Recognizer) HandleEvent(event keytypes.KeyEvent) {
+	if event.Key == keytypes.KeyUnknown {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	for k, st := range r.keys {
+		if k != event.Key {
+			st.onOther(event)
+		}
+	}
+	if st := r.keys[event.Key]; st != nil {
+		if event.Pressed {
+			st.onPress(event)
+		} else {
+			st.onRelease(event)
+		}
+	}
+}
+
+// emitLocked queues g for the handler. r.mu must be held.
+func (r *Recognizer) emitLocked(g Gesture) {
+	if r.handler != nil {
+		r.dispatch.post(func() { r.handler(g) })
+	}
+}
+
+// gesturePhase is where a watched key is in recognizing a gesture.
+type gesturePhase uint8
+
+const (
+	phaseIdle       gesturePhase = iota
+	phaseDown                    // pressed, tap or hold undecided
+	phaseHeld                    // recognized as a hold
+	phaseTapped                  // tapped, waiting for a second tap
+	phaseSecondDown              // second press of a possible double tap
+)
+
+// gestureState tracks one watched key. All fields are guarded by r.mu.
+type gestureState struct {
+	r      *Recognizer
+	key    keytypes.Key
+	config GestureConfig
+
+	phase   gesturePhase
+	first   keytypes.KeyEvent // first press of the gesture
+	press   keytypes.KeyEvent // current press
+	tapHeld time.Duration     // how long the first tap was held
+	timer   *time.Timer
+	gen     uint64
+
+	// interrupters are the other keys pressed during an undecided press.
+	interrupters map[keytypes.Key]bool
+}
+
+// reset drops the gesture in progress.
+func (st *gestureState) reset() {
+	st.stopTimer()
+	st.phase = phaseIdle
+	clear(st.interrupters)
+}
+
+func (st *gestureState) onPress(event keytypes.KeyEvent) {
+	switch st.phase {
+	case phaseDown, phaseHeld, phaseSecondDown:
+		return // key repeat
+	case phaseTapped:
+		if st.config.DoubleTapWindow > 0 {
+			st.phase = phaseSecondDown
+			st.press = event
+			st.startTimer(st.config.HoldThreshold, st.holdAfterTap)
+			return
+		}
+	}
+	st.begin(event, event.Time)
+}
+
+// begin starts a new gesture with the press event, observed at now.
+func (st *gestureState) begin(event keytypes.KeyEvent, now time.Time) {
+	st.phase = phaseDown
+	st.first = event
+	st.press = event
+	clear(st.interrupters)
+	st.startTimer(st.config.HoldThreshold-since(event.Time, now), st.hold)
+}
+
+func (st *gestureState) onRelease(event keytypes.KeyEvent) {
+	held := since(st.press.Time, event.Time)
+	switch st.phase {
+	case phaseDown:
+		switch {
+		case held <= st.config.TappingTerm && st.config.DoubleTapWindow > 0:
+			st.phase = phaseTapped
+			st.tapHeld = held
+			window := st.config.DoubleTapWindow
+			st.startTimer(window, func() {
+				st.emit(GestureTap, at(event.Time, window), st.tapHeld)
+				st.reset()
+			})
+		case held <= st.config.TappingTerm:
+			st.emit(GestureTap, event.Time, held)
+			st.reset()
+		default:
+			st.reset()
+		}
+	case phaseSecondDown:
+		if held <= st.config.TappingTerm {
+			st.emit(GestureDoubleTap, event.Time, since(st.first.Time, event.Time))
+		} else {
+			st.emit(GestureTap, event.Time, st.tapHeld)
+		}
+		st.reset()
+	case phaseHeld:
+		st.emit(GestureRelease, event.Time, held)
+		st.reset()
+	}
+}
+
+// onOther handles an event for a key other than st.key.
+func (st *gestureState) onOther(event keytypes.KeyEvent) {
+	switch st.phase {
+	case phaseTapped:
+		if event.Pressed {
+			st.emit(GestureTap, event.Time, st.tapHeld)
+			st.reset()
+		}
+	case phaseSecondDown:
+		if event.Pressed {
+			// The first tap stands; the second press starts afresh.
+			st.emit(GestureTap, event.Time, st.tapHeld)
+			st.begin(st.press, event.Time)
+			st.onOther(event)
+		}
+	case phaseDown:
+		st.interrupt(event)
+	}
+}
+
+// interrupt applies the interrupt policy to another key's event during an
+// undecided press.
+func (st *gestureState) interrupt(event keytypes.KeyEvent) {
+	switch st.config.Interrupt {
+	case InterruptHoldOnOtherKey:
+		if event.Pressed {
+			st.holdAt(event.Time)
+		}
+	case InterruptPermissiveHold:
+		if event.Pressed {
+			if st.interrupters == nil {
+				st.interrupters = make(map[keytypes.Key]bool)
+			}
+			st.interrupters[event.Key] = true
+		} else if st.interrupters[event.Key] {
+			st.holdAt(event.Time)
+		}
+	}
+}
+
+// hold is the hold threshold timer of an undecided press.
+func (st *gestureState) hold() {
+	st.holdAt(at(st.press.Time, st.config.HoldThreshold))
+}
+
+// holdAfterTap is the hold threshold timer of a second press: the first
+// tap stands and the second press is a hold.
+func (st *gestureState) holdAfterTap() {
+	st.emit(GestureTap, st.press.Time, st.tapHeld)
+	st.first = st.press
+	st.hold()
+}
+
+// holdAt recognizes the current press as a hold at t.
+func (st *gestureState) holdAt(t time.Time) {
+	st.phase = phaseHeld
+	st.emit(GestureHold, t, since(st.press.Time, t))
+	if lp := st.config.LongPress; lp > 0 {
+		st.startTimer(lp-since(st.press.Time, t), func() {
+			st.emit(GestureLongPress, at(st.press.Time, lp), lp)
+		})
+	} else {
+		st.stopTimer()
+	}
+}
+
+func (st *gestureState) emit(kind GestureKind, t time.Time, d time.Duration) {
+	st.r.emitLocked(Gesture{Kind: kind, Key: st.key, Modifiers: st.first.Modifiers, Time: t, Duration: d})
+}
+
+// startTimer runs fn under r.mu after d, unless the state changes first.
+func (st *gestureState) startTimer(d time.Duration, fn func()) {
+	st.stopTimer()
+	gen := st.gen
+	st.timer = time.AfterFunc(max(d, 0), func() {
+		defer st.r.dispatch.recover()
+		st.r.mu.Lock()
+		defer st.r.mu.Unlock()
+		if st.gen == gen && !st.r.closed {
+			fn()
+		}
+	})
+}
+
+func (st *gestureState) stopTimer() {
+	if st.timer != nil {
+		st.timer.Stop()
+		st.timer = nil
+	}
+	st.gen++
+}
+
+// at returns d after t, or the current time if t is unknown.
+func at(t time.Time, d time.Duration) time.Time {
+	if t.IsZero() {
+		return time.Now()
+	}
+	return t.Add(d)
+}
+
+// since returns the time from a to b, or 0 if either is unknown.
+func since(a, b time.Time) time.Duration {
+	if a.IsZero() || b.IsZero() {
+		return 0
+	}
+	return b.Sub(a)
+}
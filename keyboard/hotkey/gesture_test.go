@@ -0,0 +1,145 @@
+package hotkey
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keyboardtest"
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+func TestInterruptPolicies(t *testing.T) {
+	nested := func(l *keyboardtest.Listener) {
+		l.Press(keytypes.KeyCapsLock)
+		l.Tap(keytypes.KeyA)
+		l.Release(keytypes.KeyCapsLock)
+	}
+	roll := func(l *keyboardtest.Listener) {
+		l.Press(keytypes.KeyCapsLock)
+		l.Press(keytypes.KeyA)
+		l.Release(keytypes.KeyCapsLock)
+		l.Release(keytypes.KeyA)
+	}
+	alone := func(l *keyboardtest.Listener) {
+		l.Tap(keytypes.KeyCapsLock)
+	}
+	tests := []struct {
+		name   string
+		policy InterruptPolicy
+		steps  func(l *keyboardtest.Listener)
+		want   []string
+	}{
+		{"none nested", InterruptNone, nested, []string{"Tap"}},
+		{"none roll", InterruptNone, roll, []string{"Tap"}},
+		{"none alone", InterruptNone, alone, []string{"Tap"}},
+		{"hold on other key nested", InterruptHoldOnOtherKey, nested, []string{"Hold", "Release"}},
+		{"hold on other key roll", InterruptHoldOnOtherKey, roll, []string{"Hold", "Release"}},
+		{"hold on other key alone", InterruptHoldOnOtherKey, alone, []string{"Tap"}},
+		{"permissive hold nested", InterruptPermissiveHold, nested, []string{"Hold", "Release"}},
+		{"permissive hold roll", InterruptPermissiveHold, roll, []string{"Tap"}},
+		{"permissive hold alone", InterruptPermissiveHold, alone, []string{"Tap"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := keyboardtest.NewListener()
+			now := time.Unix(0, 0)
+			l.SetClock(func() time.Time {
+				now = now.Add(10 * time.Millisecond)
+				return now
+			})
+			var r recorder
+			// Timings long enough that only the interrupt policy decides.
+			rec := NewRecognizer(GestureConfig{
+				TappingTerm:     time.Hour,
+				DoubleTapWindow: -1,
+				LongPress:       -1,
+				Interrupt:       tt.policy,
+			}, func(g Gesture) { r.add(g.Kind.String()) })
+			defer rec.Close()
+			rec.Watch(keytypes.KeyCapsLock)
+			if err := l.Start(rec.HandleEvent); err != nil {
+				t.Fatal(err)
+			}
+			tt.steps(l)
+			if got := r.take(&rec.dispatch); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGestureTimings(t *testing.T) {
+	config := GestureConfig{
+		TappingTerm:     200 * time.Millisecond,
+		DoubleTapWindow: 200 * time.Millisecond,
+		LongPress:       300 * time.Millisecond,
+	}
+	tests := []struct {
+		name  string
+		steps func(l *keyboardtest.Listener)
+		want  []string
+	}{
+		{"tap", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyEscape)
+		}, []string{"Tap"}},
+		{"double tap", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyEscape)
+			l.Tap(keytypes.KeyEscape)
+		}, []string{"DoubleTap"}},
+		{"tap interrupted before second", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyEscape)
+			l.Tap(keytypes.KeyA)
+		}, []string{"Tap"}},
+		{"long press", func(l *keyboardtest.Listener) {
+			l.Press(keytypes.KeyEscape)
+			time.Sleep(400 * time.Millisecond)
+			l.Release(keytypes.KeyEscape)
+		}, []string{"Hold", "LongPress", "Release"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := keyboardtest.NewListener()
+			var r recorder
+			rec := NewRecognizer(config, func(g Gesture) { r.add(g.Kind.String()) })
+			defer rec.Close()
+			rec.Watch(keytypes.KeyEscape)
+			if err := l.Start(rec.HandleEvent); err != nil {
+				t.Fatal(err)
+			}
+			tt.steps(l)
+			if got := r.waitFor(&rec.dispatch, len(tt.want)); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGestureHandlerPanicIsReported(t *testing.T) {
+	l := keyboardtest.NewListener()
+	var r recorder
+	rec := NewRecognizer(GestureConfig{DoubleTapWindow: -1, LongPress: -1}, func(g Gesture) {
+		if g.Key == keytypes.KeyCapsLock {
+			panic("gesture")
+		}
+		r.add(g.Kind.String())
+	})
+	defer rec.Close()
+	reported := make(chan error, 1)
+	rec.SetErrorHandler(func(err error) { reported <- err })
+	rec.Watch(keytypes.KeyCapsLock, keytypes.KeyEscape)
+	if err := l.Start(rec.HandleEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	l.Tap(keytypes.KeyCapsLock)
+	l.Tap(keytypes.KeyEscape)
+	if got := r.take(&rec.dispatch); !slices.Equal(got, []string{"Tap"}) {
+		t.Errorf("gestures after a panic: got %v, want [Tap]", got)
+	}
+	var perr *keytypes.PanicError
+	if err := <-reported; !errors.As(err, &perr) || perr.Value != "gesture" {
+		t.Errorf("reported %v, want PanicError for gesture", err)
+	}
+}
@@ -0,0 +1,335 @@
+package hotkey
+
+import (
+	"slices"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// BaseLayer is the name of the layer that is always active at the bottom
+// of a Manager's stack, and that the Manager's own Register methods add
+// to.
+const BaseLayer = "normal"
+
+// Layer is a named table of bindings, such as a Vim-style mode. Layers
+// other than the base one are inactive until entered, by Enter or by a
+// leader, momentary or toggle binding, and then sit on a stack above the
+// base layer. A key is matched against the top layer first and, if that
+// layer falls through, against the ones below it.
+type Layer struct {
+	manager     *Manager
+	name        string
+	fallThrough bool
+	onEnter     func()
+	onExit      func()
+
+	// bindings and root are guarded by manager.mu.
+	bindings []*Binding
+	root     *node // built from bindings on demand
+}
+
+// LayerOption configures a layer created by NewLayer.
+type LayerOption func(*Layer)
+
+// FallThrough makes keys that match no binding in the layer go on to the
+// layer below it. Without it the layer hides every binding below, so it
+// should include a binding that leaves it.
+func FallThrough() LayerOption {
+	return func(l *Layer) { l.fallThrough = true }
+}
+
+// OnEnter sets fn to run when the layer becomes active. It runs on the
+// handler goroutine, ordered with the handlers.
+func OnEnter(fn func()) LayerOption {
+	return func(l *Layer) { l.onEnter = fn }
+}
+
+// OnExit sets fn to run when the layer stops being active. It runs on the
+// handler goroutine, ordered with the handlers.
+func OnExit(fn func()) LayerOption {
+	return func(l *Layer) { l.onExit = fn }
+}
+
+// activationKind is how a layer on the stack was entered, which decides
+// what makes it leave.
+type activationKind uint8
+
+const (
+	activeToggled   activationKind = iota // until toggled off or Exit
+	activeMomentary                       // while key is held
+	activeOneShot                         // for one action after a leader
+)
+
+// activation is a layer on the stack.
+type activation struct {
+	layer *Layer
+	kind  activationKind
+	key   keytypes.Key // the held key of a momentary layer
+	timer *time.Timer  // the timeout of a one-shot layer
+}
+
+func (a *activation) stopTimer() {
+	if a.timer != nil {
+		a.timer.Stop()
+		a.timer = nil
+	}
+}
+
+// NewLayer creates an inactive layer.
+func (m *Manager) NewLayer(name string, opts ...LayerOption) (*Layer, error) {
+	l := &Layer{manager: m, name: name}
+	for _, opt := range opts {
+		opt(l)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, ErrClosed
+	}
+	if m.layerLocked(name) != nil {
+		return nil, ErrLayerExists
+	}
+	m.layers = append(m.layers, l)
+	return l, nil
+}
+
+// Layer returns the layer with the given name, or nil if there is none.
+// BaseLayer names the base layer.
+func (m *Manager) Layer(name string) *Layer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.layerLocked(name)
+}
+
+func (m *Manager) layerLocked(name string) *Layer {
+	for _, l := range m.layers {
+		if l.name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// Base returns the base layer.
+func (m *Manager) Base() *Layer { return m.base }
+
+// CurrentMode returns the name of the top active layer, or BaseLayer when
+// no other layer is active.
+func (m *Manager) CurrentMode() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n := len(m.stack); n > 0 {
+		return m.stack[n-1].layer.name
+	}
+	return BaseLayer
+}
+
+// Modes returns the names of the active layers from the bottom of the
+// stack, which is always BaseLayer, to the top.
+func (m *Manager) Modes() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	modes := []string{BaseLayer}
+	for _, a := range m.stack {
+		modes = append(modes, a.layer.name)
+	}
+	return modes
+}
+
+// searchOrderLocked returns the layers a new key is matched against, top
+// first. m.mu must be held.
+func (m *Manager) searchOrderLocked() []*Layer {
+	var order []*Layer
+	for i := len(m.stack) - 1; i >= 0; i-- {
+		l := m.stack[i].layer
+		order = append(order, l)
+		if !l.fallThrough {
+			return order
+		}
+	}
+	return append(order, m.base)
+}
+
+// activationLocked returns l's entry on the stack, or nil. m.mu must be
+// held.
+func (m *Manager) activationLocked(l *Layer) *activation {
+	for _, a := range m.stack {
+		if a.layer == l {
+			return a
+		}
+	}
+	return nil
+}
+
+// enterLocked pushes l onto the stack unless it is already active. m.mu
+// must be held.
+func (m *Manager) enterLocked(l *Layer, kind activationKind, key keytypes.Key) {
+	if l == m.base || m.activationLocked(l) != nil {
+		return
+	}
+	a := &activation{layer: l, kind: kind, key: key}
+	m.stack = append(m.stack, a)
+	m.clearPendingLocked()
+	if kind == activeOneShot && m.timeout > 0 {
+		a.timer = time.AfterFunc(m.timeout, func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			m.exitLocked(a)
+		})
+	}
+	if fn := l.onEnter; fn != nil {
+		m.dispatch.post(fn)
+	}
+}
+
+// exitLocked removes a from the stack. It is a no-op if a is no longer on
+// it. m.mu must be held.
+func (m *Manager) exitLocked(a *activation) {
+	i := slices.Index(m.stack, a)
+	if i < 0 {
+		return
+	}
+	a.stopTimer()
+	m.stack = slices.Delete(m.stack, i, i+1)
+	m.clearPendingLocked()
+	if fn := a.layer.onExit; fn != nil {
+		m.dispatch.post(fn)
+	}
+}
+
+// Name returns the layer's name.
+func (l *Layer) Name() string { return l.name }
+
+// Register parses a chord or sequence with ParseSequence and registers
+// handler for it in the layer.
+func (l *Layer) Register(seq string, handler Handler, opts ...Option) (*Binding, error) {
+	s, err := ParseSequence(seq)
+	if err != nil {
+		return nil, err
+	}
+	return l.RegisterSequence(s, handler, opts...)
+}
+
+// RegisterChord registers handler for a single chord in the layer.
+func (l *Layer) RegisterChord(chord keytypes.Chord, handler Handler, opts ...Option) (*Binding, error) {
+	return l.RegisterSequence(Sequence{chord}, handler, opts...)
+}
+
+// RegisterSequence registers handler for a sequence of chords in the
+// layer, as described for Manager.RegisterSequence. Conflicts are only
+// checked against the layer's own bindings.
+func (l *Layer) RegisterSequence(seq Sequence, handler Handler, opts ...Option) (*Binding, error) {
+	if handler == nil {
+		return nil, ErrNilHandler
+	}
+	return l.register(seq, handler, nil, opts)
+}
+
+// register builds and registers a binding in the layer.
+func (l *Layer) register(seq Sequence, handler Handler, action func(keytypes.KeyEvent), opts []Option) (*Binding, error) {
+	if len(seq) == 0 {
+		_, err := ParseSequence("")
+		return nil, err
+	}
+	b := &Binding{manager: l.manager, layer: l, seq: slices.Clone(seq), handler: handler, action: action}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return l.manager.register(b)
+}
+
+// BindLeader registers seq in the layer as a leader for target: typing it
+// activates target for one action, the next binding or unbound key, after
+// which target leaves again. target also leaves if nothing is typed within
+// the Manager's timeout.
+func (l *Layer) BindLeader(seq string, target *Layer, opts ...Option) (*Binding, error) {
+	return l.bind(seq, target, activeOneShot, opts)
+}
+
+// BindMomentary registers seq in the layer to activate target while the
+// key of its last chord is held.
+func (l *Layer) BindMomentary(seq string, target *Layer, opts ...Option) (*Binding, error) {
+	return l.bind(seq, target, activeMomentary, opts)
+}
+
+// BindToggle registers seq in the layer to activate target, or deactivate
+// it if it is already active. A layer that does not fall through can bind
+// a toggle for itself to provide its own way out.
+func (l *Layer) BindToggle(seq string, target *Layer, opts ...Option) (*Binding, error) {
+	return l.bind(seq, target, activeToggled, opts)
+}
+
+// bind registers an activation binding for target.
+func (l *Layer) bind(seq string, target *Layer, kind activationKind, opts []Option) (*Binding, error) {
+	if target == nil || target.manager != l.manager || target == l.manager.base {
+		return nil, ErrInvalidLayer
+	}
+	s, err := ParseSequence(seq)
+	if err != nil {
+		return nil, err
+	}
+	m := l.manager
+	action := func(event keytypes.KeyEvent) {
+		if kind == activeToggled {
+			if a := m.activationLocked(target); a != nil {
+				m.exitLocked(a)
+				return
+			}
+		}
+		m.enterLocked(target, kind, event.Key)
+	}
+	if kind == activeMomentary {
+		// The layer must be active while the key is down, whatever the
+		// options say.
+		opts = append(slices.Clone(opts), func(b *Binding) { b.onRelease = false })
+	}
+	return l.register(s, nil, action, opts)
+}
+
+// Enter activates the layer until Exit is called or a toggle binding
+// deactivates it. It is a no-op for the base layer and for a layer that
+// is already active.
+func (l *Layer) Enter() {
+	m := l.manager
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.closed {
+		m.enterLocked(l, activeToggled, keytypes.KeyUnknown)
+	}
+}
+
+// Exit deactivates the layer, however it was activated. It is a no-op if
+// the layer is not active.
+func (l *Layer) Exit() {
+	m := l.manager
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if a := m.activationLocked(l); a != nil {
+		m.exitLocked(a)
+	}
+}
+
+// Active returns true if the layer is on the stack. The base layer is
+// always active.
+func (l *Layer) Active() bool {
+	m := l.manager
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return l == m.base || m.activationLocked(l) != nil
+}
+
+// Bindings returns the layer's bindings in registration order.
+func (l *Layer) Bindings() []*Binding {
+	l.manager.mu.Lock()
+	defer l.manager.mu.Unlock()
+	return slices.Clone(l.bindings)
+}
+
+// trie returns the layer's sequence trie. manager.mu must be held.
+func (l *Layer) trie() *node {
+	if l.root == nil {
+		l.root = buildTrie(l.bindings)
+	}
+	return l.root
+}
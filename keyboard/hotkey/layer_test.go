@@ -0,0 +1,198 @@
+package hotkey
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keyboardtest"
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+func TestLayerExitRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps func(l *keyboardtest.Listener)
+		want  []string // handlers and hooks run, in order
+		mode  string   // CurrentMode afterwards
+	}{
+		{"leader then binding", func(l *keyboardtest.Listener) {
+			l.Combo(keytypes.ModCtrl, keytypes.KeySpace)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter launcher", "launcher T", "exit launcher"}, BaseLayer},
+		{"leader then unbound key", func(l *keyboardtest.Listener) {
+			l.Combo(keytypes.ModCtrl, keytypes.KeySpace)
+			l.Tap(keytypes.KeyQ)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter launcher", "exit launcher", "normal T"}, BaseLayer},
+		{"leader then modifier", func(l *keyboardtest.Listener) {
+			l.Combo(keytypes.ModCtrl, keytypes.KeySpace)
+			l.Combo(keytypes.ModShift, keytypes.KeyT)
+		}, []string{"enter launcher", "launcher Shift+T", "exit launcher"}, BaseLayer},
+		{"leader times out", func(l *keyboardtest.Listener) {
+			l.Combo(keytypes.ModCtrl, keytypes.KeySpace)
+			time.Sleep(60 * time.Millisecond)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter launcher", "exit launcher", "normal T"}, BaseLayer},
+		{"momentary held", func(l *keyboardtest.Listener) {
+			l.Press(keytypes.KeyF1)
+			l.Tap(keytypes.KeyT)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter nav", "nav T", "nav T"}, "nav"},
+		{"momentary released", func(l *keyboardtest.Listener) {
+			l.Press(keytypes.KeyF1)
+			l.Tap(keytypes.KeyT)
+			l.Release(keytypes.KeyF1)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter nav", "nav T", "exit nav", "normal T"}, BaseLayer},
+		{"momentary on modifier key", func(l *keyboardtest.Listener) {
+			l.Press(keytypes.KeyAltRight)
+			l.Tap(keytypes.KeyT)
+			l.Release(keytypes.KeyAltRight)
+		}, []string{"enter nav", "nav T", "exit nav"}, BaseLayer},
+		{"toggle", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyF2)
+			l.Tap(keytypes.KeyT)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter nav", "nav T", "nav T"}, "nav"},
+		{"toggle off", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyF2)
+			l.Tap(keytypes.KeyF2)
+			l.Tap(keytypes.KeyT)
+		}, []string{"enter nav", "exit nav", "normal T"}, BaseLayer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, l := startManager(t)
+			m.SetTimeout(30 * time.Millisecond)
+			var r recorder
+			newLayer := func(name string) *Layer {
+				layer, err := m.NewLayer(name, OnEnter(func() { r.add("enter " + name) }), OnExit(func() { r.add("exit " + name) }))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, seq := range []string{"T", "Shift+T"} {
+					if _, err := layer.Register(seq, r.handler(name+" "+seq)); err != nil {
+						t.Fatal(err)
+					}
+				}
+				return layer
+			}
+			launcher, nav := newLayer("launcher"), newLayer("nav")
+			if _, err := m.Register("T", r.handler("normal T")); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := m.Base().BindLeader("Ctrl+Space", launcher); err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range []string{"F1", "AltRight"} {
+				if _, err := m.Base().BindMomentary(key, nav); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := m.Base().BindToggle("F2", nav); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := nav.BindToggle("F2", nav); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.steps(l)
+			if got := r.waitFor(&m.dispatch, len(tt.want)); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if got := m.CurrentMode(); got != tt.mode {
+				t.Errorf("CurrentMode = %q, want %q", got, tt.mode)
+			}
+		})
+	}
+}
+
+func TestReleaseBindingUnregisteredWhileArmed(t *testing.T) {
+	m, l := startManager(t)
+	nav, err := m.NewLayer("nav")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r recorder
+	toggle, err := m.Base().BindToggle("F2", nav, OnRelease())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := m.Register("F3", r.handler("F3"), OnRelease())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l.Press(keytypes.KeyF2)
+	l.Press(keytypes.KeyF3)
+	toggle.Unregister()
+	b.Unregister()
+	l.Release(keytypes.KeyF2)
+	l.Release(keytypes.KeyF3)
+	if nav.Active() {
+		t.Error("unregistered toggle activated its layer")
+	}
+	if got := r.take(&m.dispatch); len(got) != 0 {
+		t.Errorf("unregistered binding fired: %v", got)
+	}
+}
+
+func TestReleasePrefixBinding(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps func(l *keyboardtest.Listener)
+		want  []string
+	}{
+		{"held through timeout", func(l *keyboardtest.Listener) {
+			l.Press(keytypes.KeyG)
+			time.Sleep(60 * time.Millisecond)
+			l.Release(keytypes.KeyG)
+		}, []string{"G released"}},
+		{"tapped then timeout", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+		}, []string{"G released"}},
+		{"held while interrupted", func(l *keyboardtest.Listener) {
+			l.Press(keytypes.KeyG)
+			l.Tap(keytypes.KeyH)
+			l.Release(keytypes.KeyG)
+		}, []string{"H", "G released"}},
+		{"tapped then interrupted", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+			l.Tap(keytypes.KeyH)
+		}, []string{"G released", "H"}},
+		{"completed", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+			l.Tap(keytypes.KeyG)
+		}, []string{"G G"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, l := startManager(t)
+			m.SetTimeout(30 * time.Millisecond)
+			var r recorder
+			_, err := m.Register("G", func(e Event) {
+				if !e.KeyEvent.Pressed {
+					r.add("G released")
+				}
+			}, OnRelease(), AllowPrefix())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := m.Register("G G", r.handler("G G"), AllowPrefix()); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := m.Register("H", r.handler("H")); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.steps(l)
+			got := r.waitFor(&m.dispatch, len(tt.want))
+			time.Sleep(60 * time.Millisecond)
+			got = append(got, r.take(&m.dispatch)...)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,563 @@
+package hotkey
+
+import (
+	"errors"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Errors returned by Manager.
+var (
+	// ErrClosed is returned when a Manager is used after Close.
+	ErrClosed = errors.New("hotkey manager is closed")
+
+	// ErrNilHandler is returned when a chord is registered with a nil
+	// handler.
+	ErrNilHandler = errors.New("handler cannot be nil")
+
+	// ErrNoSource is returned by Start on a Manager created without an
+	// event source.
+	ErrNoSource = errors.New("hotkey manager has no event source")
+
+	// ErrLayerExists is returned by NewLayer for a name already in use.
+	ErrLayerExists = errors.New("layer already exists")
+
+	// ErrInvalidLayer is returned when a binding would activate the base
+	// layer or a layer of another Manager.
+	ErrInvalidLayer = errors.New("layer cannot be activated")
+)
+
+// DefaultTimeout is how long a Manager waits for the next step of a
+// sequence unless changed with SetTimeout or WithTimeout.
+const DefaultTimeout = time.Second
+
+// Event describes a triggered hotkey.
+type Event struct {
+	// Binding is the registration that fired.
+	Binding *Binding
+
+	// KeyEvent is the press of the sequence's last chord or, for OnRelease
+	// bindings, the release of its key.
+	KeyEvent keytypes.KeyEvent
+}
+
+// Handler is called when a hotkey fires.
+type Handler func(Event)
+
+// Manager matches keyboard events against registered chords and sequences
+// and runs their handlers. All methods are safe for concurrent use.
+type Manager struct {
+	src      keytypes.EventSource
+	dispatch dispatcher
+
+	mu        sync.Mutex
+	base      *Layer
+	layers    []*Layer      // in creation order, base first
+	stack     []*activation // active layers above base, bottom to top
+	timeout   time.Duration
+	onPending func(Sequence)
+	down      map[keytypes.Key]bool
+	armed     map[keytypes.Key][]*Binding
+	closed    bool
+
+	// The sequence in progress: the trie nodes reached, the chords that
+	// reached them, the press of the last one and, once its key is up, the
+	// release, and the timer abandoning them, identified by gen.
+	pending        []*node
+	pendingSeq     Sequence
+	pendingEvent   keytypes.KeyEvent
+	pendingRelease keytypes.KeyEvent
+	timer          *time.Timer
+	gen            uint64
+}
+
+// New creates a Manager for src. Nothing is delivered until Start is
+// called. src may be nil for a Manager that is only fed through
+// HandleEvent.
+func New(src keytypes.EventSource) *Manager {
+	m := &Manager{
+		src:     src,
+		timeout: DefaultTimeout,
+		down:    make(map[keytypes.Key]bool),
+		armed:   make(map[keytypes.Key][]*Binding),
+	}
+	m.base = &Layer{manager: m, name: BaseLayer}
+	m.layers = []*Layer{m.base}
+	return m
+}
+
+// SetTimeout sets how long the Manager waits between the steps of a
+// sequence before abandoning it. Zero waits indefinitely, as Emacs does.
+// Bindings registered with WithTimeout keep their own.
+func (m *Manager) SetTimeout(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.timeout = max(d, 0)
+}
+
+// SetPendingHandler sets fn to be called when a sequence is in progress,
+// with the chords typed so far (so a UI can show "Ctrl+X-"), and with nil
+// when the sequence completes, is abandoned or times out. fn runs on the
+// handler goroutine, ordered with the handlers. A nil fn removes it.
+func (m *Manager) SetPendingHandler(fn func(Sequence)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onPending = fn
+}
+
+// SetErrorHandler sets a function called with a *keytypes.PanicError when a
+// handler, a layer's OnEnter or OnExit hook or the pending handler panics.
+// The panic is recovered and later handlers still run. handler runs on the
+// handler goroutine; panics in it are discarded. A nil handler removes it.
+func (m *Manager) SetErrorHandler(handler func(error)) {
+	m.dispatch.setErrorHandler(handler)
+}
+
+// Start starts the event source with HandleEvent as its callback.
+func (m *Manager) Start() error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrClosed
+	}
+	if m.src == nil {
+		m.mu.Unlock()
+		return ErrNoSource
+	}
+	m.resetLocked()
+	m.mu.Unlock()
+	return m.src.Start(m.HandleEvent)
+}
+
+// Stop stops the event source. Handlers already triggered still run.
+func (m *Manager) Stop() {
+	if m.src != nil {
+		m.src.Stop()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resetLocked()
+}
+
+// Close stops the event source, deactivates every layer without running
+// its exit hook, and unregisters every binding. The source itself is not
+// closed. Safe to call multiple times.
+func (m *Manager) Close() {
+	m.Stop()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	for _, a := range m.stack {
+		a.stopTimer()
+	}
+	m.stack = nil
+	for _, l := range m.layers {
+		for _, b := range l.bindings {
+			b.registered = false
+		}
+		l.bindings = nil
+		l.root = nil
+	}
+}
+
+// resetLocked forgets which keys are down, any sequence in progress, and
+// the layers activated by a held key or a leader. m.mu must be held.
+func (m *Manager) resetLocked() {
+	clear(m.down)
+	clear(m.armed)
+	m.clearPendingLocked()
+	for _, a := range slices.Clone(m.stack) {
+		if a.kind != activeToggled {
+			m.exitLocked(a)
+		}
+	}
+}
+
+// Register parses a chord or a whitespace-separated sequence of chords
+// ("Ctrl+X Ctrl+S") with ParseSequence and registers handler for it in the
+// base layer.
+func (m *Manager) Register(seq string, handler Handler, opts ...Option) (*Binding, error) {
+	return m.base.Register(seq, handler, opts...)
+}
+
+// RegisterChord registers handler for a single chord in the base layer.
+func (m *Manager) RegisterChord(chord keytypes.Chord, handler Handler, opts ...Option) (*Binding, error) {
+	return m.base.RegisterChord(chord, handler, opts...)
+}
+
+// RegisterSequence registers handler for a sequence of chords in the base
+// layer.
+//
+// A sequence that matches the same keystrokes as a registered one, or is a
+// prefix of one or extends one, is rejected with a *ConflictError unless
+// both bindings were registered with AllowDuplicate or AllowPrefix
+// respectively. Chords conflict when a single press could match both, so
+// "Ctrl+S" and "LeftCtrl+S" do while "Ctrl+S" and "Ctrl+Shift+S" do not.
+//
+// For allowed prefixes ("G" and "G G"), the Manager waits after the shorter
+// sequence: the longer fires if it is completed in time, and otherwise the
+// shorter fires on the timeout or on the next key that does not continue
+// it.
+func (m *Manager) RegisterSequence(seq Sequence, handler Handler, opts ...Option) (*Binding, error) {
+	return m.base.RegisterSequence(seq, handler, opts...)
+}
+
+// register adds b to its layer after checking for conflicts there.
+func (m *Manager) register(b *Binding) (*Binding, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, ErrClosed
+	}
+	if err := m.conflictLocked(b); err != nil {
+		return nil, err
+	}
+	l := b.layer
+	b.registered = true
+	l.bindings = append(l.bindings, b)
+	l.root = nil
+	return b, nil
+}
+
+// Bindings returns the registered bindings of every layer, layer by layer
+// in creation order starting with the base layer, each in registration
+// order.
+func (m *Manager) Bindings() []*Binding {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var all []*Binding
+	for _, l := range m.layers {
+		all = append(all, l.bindings...)
+	}
+	return all
+}
+
+// Lookup returns the registered bindings, in any layer, that clash with
+// seq as Register would see it: those matching the same keystrokes, and
+// those it is a prefix of or extends.
+func (m *Manager) Lookup(seq Sequence) []*Binding {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var found []*Binding
+	for _, l := range m.layers {
+		for _, b := range l.bindings {
+			if conflict(seq, b.seq) != 0 {
+				found = append(found, b)
+			}
+		}
+	}
+	return found
+}
+
+// unregister removes b. It is a no-op if b is not registered.
+func (m *Manager) unregister(b *Binding) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !b.registered {
+		return
+	}
+	l := b.layer
+	b.registered = false
+	l.bindings = slices.DeleteFunc(l.bindings, func(x *Binding) bool { return x == b })
+	l.root = nil
+}
+
+// HandleEvent matches event against the registered sequences and queues
+// the handlers it triggers. It is the callback Start installs, and can be
+// called directly to feed the Manager from another source. It never blocks
+// on a handler.
+func (m *Manager) HandleEvent(event keytypes.KeyEvent) {
+	if event.Key == keytypes.KeyUnknown {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+	if !event.Pressed {
+		delete(m.down, event.Key)
+		if m.pending != nil && event.Key == m.pendingEvent.Key {
+			m.pendingRelease = event
+		}
+		for _, b := range m.armed[event.Key] {
+			m.fireLocked(b, event)
+		}
+		delete(m.armed, event.Key)
+		for _, a := range slices.Clone(m.stack) {
+			if a.kind == activeMomentary && a.key == event.Key {
+				m.exitLocked(a)
+			}
+		}
+		return
+	}
+	if m.down[event.Key] {
+		return // key repeat
+	}
+	m.down[event.Key] = true
+	var shot *activation
+	if n := len(m.stack); n > 0 && m.stack[n-1].kind == activeOneShot {
+		shot = m.stack[n-1]
+	}
+	m.stepLocked(event)
+	// A leader's layer lasts for one action: a binding or a key that
+	// matches none.
+	if shot != nil && m.pending == nil && !isModifierKey(event.Key) {
+		m.exitLocked(shot)
+	}
+}
+
+// stepLocked advances the sequence in progress, or starts one, with a key
+// press. m.mu must be held.
+func (m *Manager) stepLocked(event keytypes.KeyEvent) {
+	// The key holding a momentary layer is not a modifier of the chords
+	// typed in it.
+	match := event
+	for _, a := range m.stack {
+		if a.kind == activeMomentary {
+			match.Modifiers = withoutKey(match.Modifiers|match.Sides, a.key)
+			match.Sides = 0
+		}
+	}
+	var matched []*node
+	if m.pending != nil {
+		matched = matchChildren(m.pending, match)
+	} else {
+		for _, l := range m.searchOrderLocked() {
+			if matched = matchChildren([]*node{l.trie()}, match); matched != nil {
+				break
+			}
+		}
+	}
+	if len(matched) == 0 {
+		// Pressing the modifiers for the next step must not abandon the
+		// sequence.
+		if m.pending == nil || isModifierKey(event.Key) {
+			return
+		}
+		m.resolveLocked()
+		m.stepLocked(event)
+		return
+	}
+
+	var prefixes []*node
+	for _, n := range matched {
+		if len(n.children) > 0 {
+			prefixes = append(prefixes, n)
+			continue
+		}
+		for _, b := range n.bindings {
+			if m.inTimeLocked(b, event) {
+				m.triggerLocked(b, event)
+			}
+		}
+	}
+	if len(prefixes) == 0 {
+		m.clearPendingLocked()
+		return
+	}
+	m.pending = prefixes
+	m.pendingSeq = append(m.pendingSeq, prefixes[0].chord)
+	m.pendingEvent = event
+	m.pendingRelease = keytypes.KeyEvent{}
+	m.startTimerLocked()
+	m.notifyLocked(slices.Clone(m.pendingSeq))
+}
+
+// matchChildren returns the children of nodes whose chord matches event.
+func matchChildren(nodes []*node, event keytypes.KeyEvent) []*node {
+	var matched []*node
+	for _, n := range nodes {
+		for _, ch := range n.children {
+			if ch.chord.Key == event.Key && matches(ch.chord, event) {
+				matched = append(matched, ch)
+			}
+		}
+	}
+	return matched
+}
+
+// inTimeLocked reports whether event, completing b's sequence, came within
+// b's timeout of the previous step. m.mu must be held.
+func (m *Manager) inTimeLocked(b *Binding, event keytypes.KeyEvent) bool {
+	if len(b.seq) == 1 || m.pendingEvent.Time.IsZero() || event.Time.IsZero() {
+		return true
+	}
+	d := b.timeoutOr(m.timeout)
+	return d == 0 || event.Time.Sub(m.pendingEvent.Time) <= d
+}
+
+// triggerLocked fires b for event, or arms it to fire on the key's release.
+// m.mu must be held.
+func (m *Manager) triggerLocked(b *Binding, event keytypes.KeyEvent) {
+	if b.onRelease {
+		m.armed[event.Key] = append(m.armed[event.Key], b)
+	} else {
+		m.fireLocked(b, event)
+	}
+}
+
+// resolveLocked ends the sequence in progress, firing the bindings that end
+// where it stopped, if any. OnRelease bindings whose key is still down are
+// armed to fire on its release instead. m.mu must be held.
+func (m *Manager) resolveLocked() {
+	for _, n := range m.pending {
+		for _, b := range n.bindings {
+			switch {
+			case !b.onRelease:
+				m.fireLocked(b, m.pendingEvent)
+			case m.down[m.pendingEvent.Key]:
+				m.triggerLocked(b, m.pendingEvent)
+			case m.pendingRelease.Key != keytypes.KeyUnknown:
+				m.fireLocked(b, m.pendingRelease)
+			}
+		}
+	}
+	m.clearPendingLocked()
+}
+
+// clearPendingLocked abandons the sequence in progress. m.mu must be held.
+func (m *Manager) clearPendingLocked() {
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+	m.gen++
+	if m.pending == nil {
+		return
+	}
+	m.pending = nil
+	m.pendingSeq = nil
+	m.pendingEvent = keytypes.KeyEvent{}
+	m.pendingRelease = keytypes.KeyEvent{}
+	m.notifyLocked(nil)
+}
+
+// startTimerLocked (re)starts the timer that resolves the sequence in
+// progress. m.mu must be held.
+func (m *Manager) startTimerLocked() {
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+	m.gen++
+	var wait time.Duration
+	for _, n := range m.pending {
+		d := n.wait(m.timeout)
+		if d == 0 {
+			return
+		}
+		wait = max(wait, d)
+	}
+	gen := m.gen
+	m.timer = time.AfterFunc(wait, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.gen == gen {
+			m.resolveLocked()
+		}
+	})
+}
+
+// notifyLocked queues a pending-sequence notification. m.mu must be held.
+func (m *Manager) notifyLocked(seq Sequence) {
+	if fn := m.onPending; fn != nil {
+		m.dispatch.post(func() { fn(seq) })
+	}
+}
+
+// fireLocked runs b's layer action, if any, and queues its handler for
+// event. Bindings unregistered since they were armed or became pending do
+// nothing. m.mu must be held.
+func (m *Manager) fireLocked(b *Binding, event keytypes.KeyEvent) {
+	if !b.registered {
+		return
+	}
+	if b.action != nil {
+		b.action(event)
+	}
+	if b.handler == nil {
+		return
+	}
+	m.dispatch.post(func() {
+		if b.Registered() {
+			b.handler(Event{Binding: b, KeyEvent: event})
+		}
+	})
+}
+
+// dispatcher runs queued functions one at a time on a goroutine that
+// exists only while the queue is non-empty. A panicking function is
+// reported to the error handler and the queue keeps draining.
+type dispatcher struct {
+	mu      sync.Mutex
+	queue   []func()
+	running bool
+
+	errHandler atomic.Pointer[func(error)]
+}
+
+func (d *dispatcher) setErrorHandler(handler func(error)) {
+	if handler == nil {
+		d.errHandler.Store(nil)
+		return
+	}
+	d.errHandler.Store(&handler)
+}
+
+// post queues fn without waiting for it to run.
+func (d *dispatcher) post(fn func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.queue = append(d.queue, fn)
+	if !d.running {
+		d.running = true
+		go d.run()
+	}
+}
+
+func (d *dispatcher) run() {
+	for {
+		d.mu.Lock()
+		if len(d.queue) == 0 {
+			d.running = false
+			d.mu.Unlock()
+			return
+		}
+		fn := d.queue[0]
+		d.queue[0] = nil
+		d.queue = d.queue[1:]
+		d.mu.Unlock()
+		d.call(fn)
+	}
+}
+
+func (d *dispatcher) call(fn func()) {
+	defer d.recover()
+	fn()
+}
+
+// recover recovers a panic in progress and queues its report as a
+// *keytypes.PanicError, so that the error handler runs on the handler
+// goroutine even for panics on timer goroutines. It must be deferred
+// directly.
+func (d *dispatcher) recover() {
+	if r := recover(); r != nil {
+		err := &keytypes.PanicError{Value: r, Stack: debug.Stack()}
+		d.post(func() { d.report(err) })
+	}
+}
+
+// report delivers err to the error handler; panics in the handler are
+// discarded.
+func (d *dispatcher) report(err error) {
+	if h := d.errHandler.Load(); h != nil {
+		defer func() { _ = recover() }()
+		(*h)(err)
+	}
+}
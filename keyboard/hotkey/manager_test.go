@@ -0,0 +1,202 @@
+package hotkey
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keyboardtest"
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// recorder collects the names of the handlers that ran.
+type recorder struct {
+	mu  sync.Mutex
+	got []string
+}
+
+// handler returns a Handler recording name.
+func (r *recorder) handler(name string) Handler {
+	return func(Event) { r.add(name) }
+}
+
+func (r *recorder) add(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.got = append(r.got, name)
+}
+
+// take waits for the handlers queued on d so far and returns and forgets
+// what they recorded.
+func (r *recorder) take(d *dispatcher) []string {
+	flush(d)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	got := r.got
+	r.got = nil
+	return got
+}
+
+// waitFor waits up to a second for n names to be recorded, for handlers
+// triggered by timers, and returns what was recorded.
+func (r *recorder) waitFor(d *dispatcher, n int) []string {
+	deadline := time.Now().Add(time.Second)
+	for {
+		flush(d)
+		r.mu.Lock()
+		done := len(r.got) >= n || time.Now().After(deadline)
+		r.mu.Unlock()
+		if done {
+			return r.take(d)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// flush waits until the functions queued on d so far have run.
+func flush(d *dispatcher) {
+	done := make(chan struct{})
+	d.post(func() { close(done) })
+	<-done
+}
+
+// startManager returns a started Manager fed by a fake listener.
+func startManager(t *testing.T) (*Manager, *keyboardtest.Listener) {
+	t.Helper()
+	l := keyboardtest.NewListener()
+	m := New(l)
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(m.Close)
+	return m, l
+}
+
+func TestChordMatching(t *testing.T) {
+	tests := []struct {
+		chord   string
+		modKeys keytypes.ModifierKeys
+		mods    keytypes.Modifier
+		key     keytypes.Key
+		want    bool
+	}{
+		{"Ctrl+S", keytypes.ModifierKeys{}, keytypes.ModCtrl, keytypes.KeyS, true},
+		{"Ctrl+S", keytypes.ModifierKeys{}, keytypes.ModCtrl | keytypes.ModShift, keytypes.KeyS, false},
+		{"Ctrl+S", keytypes.ModifierKeys{}, 0, keytypes.KeyS, false},
+		{"Ctrl+S", keytypes.ModifierKeys{}, keytypes.ModRightCtrl, keytypes.KeyS, true},
+		{"Ctrl+S", keytypes.ModifierKeys{}, keytypes.ModCtrl | keytypes.ModCapsLock, keytypes.KeyS, true},
+		{"RightCtrl+S", keytypes.ModifierKeys{}, keytypes.ModRightCtrl, keytypes.KeyS, true},
+		{"RightCtrl+S", keytypes.ModifierKeys{}, keytypes.ModLeftCtrl, keytypes.KeyS, false},
+		{"RightCtrl+S", keytypes.ModifierKeys{}, keytypes.ModLeftCtrl | keytypes.ModRightCtrl, keytypes.KeyS, true},
+		{"Ctrl+ShiftLeft", keytypes.ModifierKeys{}, keytypes.ModCtrl, keytypes.KeyShiftLeft, true},
+		{"AltGr+E", keytypes.ModifierKeys{AltGr: keytypes.KeyAltRight}, keytypes.ModAltGr, keytypes.KeyE, true},
+		{"AltGr+E", keytypes.ModifierKeys{}, keytypes.ModRightAlt, keytypes.KeyE, false},
+		{"Alt+E", keytypes.ModifierKeys{AltGr: keytypes.KeyAltRight}, keytypes.ModRightAlt, keytypes.KeyE, false},
+		{"Alt+E", keytypes.ModifierKeys{AltGr: keytypes.KeyAltRight}, keytypes.ModLeftAlt, keytypes.KeyE, true},
+		{"Meta+X", keytypes.ModifierKeys{Meta: keytypes.KeyAltLeft}, keytypes.ModMeta, keytypes.KeyX, true},
+		{"Hyper+K", keytypes.ModifierKeys{Hyper: keytypes.KeyF13}, keytypes.ModHyper, keytypes.KeyK, true},
+		{"Hyper+K", keytypes.ModifierKeys{Hyper: keytypes.KeyF13}, keytypes.ModHyper | keytypes.ModCtrl, keytypes.KeyK, false},
+	}
+	for _, tt := range tests {
+		m, l := startManager(t)
+		l.SetModifierKeys(tt.modKeys)
+		var r recorder
+		if _, err := m.Register(tt.chord, r.handler(tt.chord)); err != nil {
+			t.Fatal(err)
+		}
+		l.Combo(tt.mods, tt.key)
+		if got := len(r.take(&m.dispatch)) == 1; got != tt.want {
+			t.Errorf("%s on %v+%v: fired = %v, want %v", tt.chord, tt.mods, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestRepeatOnReleaseAndUnregister(t *testing.T) {
+	m, l := startManager(t)
+	var r recorder
+	if _, err := m.Register("F5", r.handler("press")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Register("F6", r.handler("release"), OnRelease()); err != nil {
+		t.Fatal(err)
+	}
+	b, err := m.Register("F7", r.handler("gone"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l.Press(keytypes.KeyF5)
+	l.Press(keytypes.KeyF5) // repeat
+	l.Release(keytypes.KeyF5)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"press"}) {
+		t.Errorf("F5 with repeat: got %v, want [press]", got)
+	}
+
+	l.Press(keytypes.KeyF6)
+	if got := r.take(&m.dispatch); len(got) != 0 {
+		t.Errorf("OnRelease fired on press: %v", got)
+	}
+	l.Release(keytypes.KeyF6)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"release"}) {
+		t.Errorf("F6 release: got %v, want [release]", got)
+	}
+
+	b.Unregister()
+	l.Tap(keytypes.KeyF7)
+	if got := r.take(&m.dispatch); len(got) != 0 {
+		t.Errorf("unregistered binding fired: %v", got)
+	}
+}
+
+func TestHandlerPanicsAreReported(t *testing.T) {
+	m, l := startManager(t)
+	var r recorder
+	var mu sync.Mutex
+	var errs []error
+	m.SetErrorHandler(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	})
+	m.SetPendingHandler(func(seq Sequence) {
+		if seq != nil {
+			panic("pending")
+		}
+	})
+	layer, err := m.NewLayer("panicky", OnEnter(func() { panic("enter") }), OnExit(func() { panic("exit") }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Register("F5", func(Event) { panic("handler") }); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Register("Ctrl+X Ctrl+F", r.handler("sequence")); err != nil {
+		t.Fatal(err)
+	}
+
+	l.Tap(keytypes.KeyF5)
+	layer.Enter()
+	layer.Exit()
+	l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+	l.Combo(keytypes.ModCtrl, keytypes.KeyF)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"sequence"}) {
+		t.Errorf("handlers after panics: got %v, want [sequence]", got)
+	}
+
+	flush(&m.dispatch)
+	mu.Lock()
+	defer mu.Unlock()
+	var values []any
+	for _, err := range errs {
+		var perr *keytypes.PanicError
+		if !errors.As(err, &perr) || !errors.Is(err, keytypes.ErrCallbackPanic) {
+			t.Fatalf("reported %v, want a PanicError", err)
+		}
+		values = append(values, perr.Value)
+	}
+	if want := []any{"handler", "enter", "exit", "pending"}; !slices.Equal(values, want) {
+		t.Errorf("panics reported: %v, want %v", values, want)
+	}
+}
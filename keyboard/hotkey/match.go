@@ -0,0 +1,57 @@
+package hotkey
+
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+
+// genericMods are the held modifiers that must match a chord exactly.
+const genericMods = keytypes.ModCtrl | keytypes.ModAlt | keytypes.ModShift | keytypes.ModSuper
+
+// modifierKeys are the keys that drive the generic modifiers.
+var modifierKeys = []keytypes.Key{
+	keytypes.KeyCtrlLeft, keytypes.KeyCtrlRight,
+	keytypes.KeyAltLeft, keytypes.KeyAltRight,
+	keytypes.KeyShiftLeft, keytypes.KeyShiftRight,
+	keytypes.KeySuperLeft, keytypes.KeySuperRight,
+}
+
+// matches reports whether event, a press of chord.Key, has exactly the
+// chord's modifiers held. The generic modifiers must be equal; the chord's
+// side-specific and extended bits must also be present in the event.
+func matches(chord keytypes.Chord, event keytypes.KeyEvent) bool {
+	want := required(chord.Mods)
+	have := withoutKey(event.Modifiers|event.Sides, event.Key)
+	return have&genericMods == want&genericMods && have&want == want
+}
+
+// overlaps reports whether some key press could match both a and b: they
+// share a key and generic modifiers, and any side-specific or extended
+// modifiers they add can be held together.
+func overlaps(a, b keytypes.Chord) bool {
+	return a.Key == b.Key && required(a.Mods)&genericMods == required(b.Mods)&genericMods
+}
+
+// required returns the modifiers an event must carry to match mods: mods
+// with the generic bit for each side-specific one.
+func required(mods keytypes.Modifier) keytypes.Modifier {
+	return mods.Normalize() &^ keytypes.LockModifiers
+}
+
+// isModifierKey reports whether key only modifies other keys.
+func isModifierKey(key keytypes.Key) bool {
+	return key.IsModifier()
+}
+
+// withoutKey returns mods without the modifiers that key itself drives, so
+// that a chord on a modifier key ("Ctrl+ShiftLeft") is matched against the
+// other modifiers held.
+func withoutKey(mods keytypes.Modifier, key keytypes.Key) keytypes.Modifier {
+	if !key.IsModifier() {
+		return mods
+	}
+	mods &^= key.SideModifierBit()
+	for _, k := range modifierKeys {
+		if k.ModifierBit() == key.ModifierBit() && mods&k.SideModifierBit() != 0 {
+			return mods
+		}
+	}
+	return mods &^ key.ModifierBit()
+}
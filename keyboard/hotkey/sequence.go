@@ -0,0 +1,104 @@
+package hotkey
+
+import (
+	"strings"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Sequence is a series of chords pressed one after another, such as
+// "Ctrl+X Ctrl+S" or "G G".
+type Sequence []keytypes.Chord
+
+// ParseSequence parses whitespace-separated chords, each in the form
+// accepted by keyboard.ParseChord.
+func ParseSequence(s string) (Sequence, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		// Let ParseChord describe the empty input.
+		_, err := keytypes.ParseChord(s)
+		return nil, err
+	}
+	seq := make(Sequence, 0, len(fields))
+	for _, f := range fields {
+		c, err := keytypes.ParseChord(f)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, c)
+	}
+	return seq, nil
+}
+
+// Format renders the sequence in the given style, chords separated by
+// spaces.
+func (s Sequence) Format(style keytypes.ChordStyle) string {
+	parts := make([]string, len(s))
+	for i, c := range s {
+		parts[i] = c.Format(style)
+	}
+	return strings.Join(parts, " ")
+}
+
+// String renders the sequence in the Linux style.
+func (s Sequence) String() string {
+	return s.Format(keytypes.ChordStyleLinux)
+}
+
+// node is a step in the trie of registered sequences. Each node holds the
+// bindings whose sequence ends there and the steps that continue it.
+type node struct {
+	chord    keytypes.Chord
+	bindings []*Binding
+	children []*node
+}
+
+// buildTrie arranges bindings into a trie rooted at an empty node.
+func buildTrie(bindings []*Binding) *node {
+	root := &node{}
+	for _, b := range bindings {
+		n := root
+		for _, c := range b.seq {
+			n = n.child(c)
+		}
+		n.bindings = append(n.bindings, b)
+	}
+	return root
+}
+
+// child returns the child for c, adding it if needed.
+func (n *node) child(c keytypes.Chord) *node {
+	for _, ch := range n.children {
+		if ch.chord == c {
+			return ch
+		}
+	}
+	ch := &node{chord: c}
+	n.children = append(n.children, ch)
+	return ch
+}
+
+// wait returns how long to wait for the next step after n: the longest
+// timeout of any binding that continues through it, or 0 if one of them
+// waits indefinitely.
+func (n *node) wait(def time.Duration) time.Duration {
+	var d time.Duration
+	for _, ch := range n.children {
+		for _, b := range ch.bindings {
+			t := b.timeoutOr(def)
+			if t == 0 {
+				return 0
+			}
+			d = max(d, t)
+		}
+		if len(ch.children) > 0 {
+			t := ch.wait(def)
+			if t == 0 {
+				return 0
+			}
+			d = max(d, t)
+		}
+	}
+	return d
+}
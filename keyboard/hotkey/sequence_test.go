@@ -0,0 +1,146 @@
+package hotkey
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keyboardtest"
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+func TestPrefixSequences(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps func(l *keyboardtest.Listener)
+		want  []string
+	}{
+		{"completed", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+			l.Tap(keytypes.KeyG)
+		}, []string{"G G"}},
+		{"timeout", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+		}, []string{"G"}},
+		{"interrupted", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+			l.Tap(keytypes.KeyH)
+		}, []string{"G", "H"}},
+		{"interrupted by unbound key", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+			l.Tap(keytypes.KeyJ)
+		}, []string{"G"}},
+		{"modifier between steps", func(l *keyboardtest.Listener) {
+			l.Tap(keytypes.KeyG)
+			l.Tap(keytypes.KeyShiftLeft)
+			l.Tap(keytypes.KeyG)
+		}, []string{"G G"}},
+		{"emacs", func(l *keyboardtest.Listener) {
+			l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+			l.Combo(keytypes.ModCtrl, keytypes.KeyS)
+		}, []string{"Ctrl+X Ctrl+S"}},
+		{"emacs abandoned", func(l *keyboardtest.Listener) {
+			l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+			l.Tap(keytypes.KeyS)
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, l := startManager(t)
+			m.SetTimeout(20 * time.Millisecond)
+			var r recorder
+			for _, seq := range []string{"G", "G G"} {
+				if _, err := m.Register(seq, r.handler(seq), AllowPrefix()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, seq := range []string{"H", "Ctrl+X Ctrl+S"} {
+				if _, err := m.Register(seq, r.handler(seq)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			tt.steps(l)
+			got := r.waitFor(&m.dispatch, len(tt.want))
+			if len(tt.want) == 0 {
+				time.Sleep(40 * time.Millisecond)
+				got = r.take(&m.dispatch)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSequenceStepTimeout(t *testing.T) {
+	m, l := startManager(t)
+	now := time.Unix(0, 0)
+	l.SetClock(func() time.Time { return now })
+	var r recorder
+	if _, err := m.Register("Ctrl+X Ctrl+S", r.handler("save"), WithTimeout(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Register("Ctrl+X K", r.handler("kill")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The Manager's default timeout has passed, but not the binding's own.
+	l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+	now = now.Add(time.Minute)
+	l.Combo(keytypes.ModCtrl, keytypes.KeyS)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"save"}) {
+		t.Errorf("Ctrl+X Ctrl+S a minute apart: got %v, want [save]", got)
+	}
+
+	l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+	now = now.Add(time.Minute)
+	l.Tap(keytypes.KeyK)
+	if got := r.take(&m.dispatch); len(got) != 0 {
+		t.Errorf("Ctrl+X K a minute apart: got %v, want nothing", got)
+	}
+}
+
+func TestSequenceZeroTimeout(t *testing.T) {
+	m, l := startManager(t)
+	now := time.Unix(0, 0)
+	l.SetClock(func() time.Time { return now })
+	var r recorder
+	if _, err := m.Register("Ctrl+X Ctrl+S", r.handler("save"), WithTimeout(0)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Register("Ctrl+C Ctrl+C", r.handler("comment")); err != nil {
+		t.Fatal(err)
+	}
+
+	// WithTimeout(0) waits indefinitely, as SetTimeout(0) does, rather than
+	// following the Manager's timeout.
+	l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+	now = now.Add(24 * time.Hour)
+	l.Combo(keytypes.ModCtrl, keytypes.KeyS)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"save"}) {
+		t.Errorf("Ctrl+X Ctrl+S a day apart: got %v, want [save]", got)
+	}
+
+	m.SetTimeout(0)
+	l.Combo(keytypes.ModCtrl, keytypes.KeyC)
+	now = now.Add(24 * time.Hour)
+	l.Combo(keytypes.ModCtrl, keytypes.KeyC)
+	if got := r.take(&m.dispatch); !slices.Equal(got, []string{"comment"}) {
+		t.Errorf("Ctrl+C Ctrl+C a day apart with SetTimeout(0): got %v, want [comment]", got)
+	}
+}
+
+func TestPendingHandler(t *testing.T) {
+	m, l := startManager(t)
+	var r recorder
+	m.SetPendingHandler(func(seq Sequence) { r.add("pending " + seq.String()) })
+	if _, err := m.Register("Ctrl+X Ctrl+S", r.handler("save")); err != nil {
+		t.Fatal(err)
+	}
+	l.Combo(keytypes.ModCtrl, keytypes.KeyX)
+	l.Combo(keytypes.ModCtrl, keytypes.KeyS)
+	want := []string{"pending Ctrl+X", "save", "pending "}
+	if got := r.take(&m.dispatch); !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
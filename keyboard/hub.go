@@ -0,0 +1,311 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"context"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Hub shares a single EventSource between any number of subscribers.
+//
+// The source is created and started when the first subscriber attaches, and
+// stopped and closed when the last one detaches, so a binary with several
+// components interested in key events holds one native listener (one
+// libinput/udev context on Linux) instead of one per component.
+//
+// If the source has Done and Err methods, as Listener does, and stops on its
+// own, every subscription ends with the source's Err and the next
+// subscriber starts a new source.
+type Hub struct {
+	newSource func() (EventSource, error)
+
+	mu     sync.Mutex
+	src    EventSource
+	closed bool
+	subs   atomic.Pointer[[]*Subscription]
+}
+
+// Subscription is a subscriber attached to a Hub.
+type Subscription struct {
+	hub      *Hub
+	callback ListenerCallback
+	queue    *eventQueue
+	closed   atomic.Bool
+	done     chan struct{}
+	err      error
+}
+
+func newSubscription(h *Hub, callback ListenerCallback, queue *eventQueue) *Subscription {
+	return &Subscription{hub: h, callback: callback, queue: queue, done: make(chan struct{})}
+}
+
+// NewHub creates a Hub whose source is built by newSource each time the
+// first subscriber attaches. A nil newSource uses NewEventSource.
+func NewHub(newSource func() (EventSource, error)) *Hub {
+	if newSource == nil {
+		newSource = func() (EventSource, error) { return NewEventSource() }
+	}
+	return &Hub{newSource: newSource}
+}
+
+var defaultHub = sync.OnceValue(func() *Hub { return NewHub(nil) })
+
+// DefaultHub returns the process-wide Hub backed by the native Listener.
+// Closing it before the program exits stops the native listener
+// deterministically; DefaultHub then keeps returning the closed hub.
+func DefaultHub() *Hub {
+	return defaultHub()
+}
+
+// Subscribe attaches callback to the hub, starting the source if this is
+// the first subscriber. The callback runs on the source's thread, like a
+// ListenerCallback passed to Listener.Start. If it panics, the panic is
+// recovered and the subscription ends with a *PanicError.
+func (h *Hub) Subscribe(callback ListenerCallback) (*Subscription, error) {
+	if callback == nil {
+		return nil, &OpError{Op: "subscribe", Err: ErrNilCallback}
+	}
+	sub := newSubscription(h, callback, nil)
+	if err := h.attach(sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// SubscribeEvents attaches a buffered channel subscriber to the hub. The
+// options behave as for Events. When ctx is done the subscriber detaches
+// and the channel is closed; the channel is also closed when the hub's
+// source stops on its own or the hub is closed.
+func (h *Hub) SubscribeEvents(ctx context.Context, opts ...EventsOption) (<-chan KeyEvent, error) {
+	q := newEventQueue(newEventsConfig(opts))
+	sub := newSubscription(h, nil, q)
+	if err := h.attach(sub); err != nil {
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-sub.done:
+		}
+		q.close(sub.detach)
+	}()
+	return q.ch, nil
+}
+
+// Subscribers returns the number of attached subscribers.
+func (h *Hub) Subscribers() int {
+	if subs := h.subs.Load(); subs != nil {
+		return len(*subs)
+	}
+	return 0
+}
+
+// IsListening returns true if the hub's source is running.
+func (h *Hub) IsListening() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.src != nil && h.src.IsListening()
+}
+
+// Close stops the hub's source, if running, and ends every subscription
+// with a nil Err. Subscribe fails with ErrListenerClosed afterwards. Safe to
+// call multiple times. Close waits for the source to stop, so it must not
+// be called from within a hub callback.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	h.closed = true
+	subs := h.subs.Swap(nil)
+	// A dispatch blocked on a subscriber's full queue holds up the source's
+	// thread, which Stop joins.
+	unblockAll(subs)
+	if h.src != nil {
+		h.src.Stop()
+		h.src.Close()
+		h.src = nil
+	}
+	h.mu.Unlock()
+	endAll(subs, nil)
+}
+
+// Close detaches the subscriber, stopping and closing the hub's source if
+// it was the last one. Safe to call multiple times. Close waits for the
+// source to stop, so it must not be called from within a hub callback.
+func (s *Subscription) Close() {
+	s.detach()
+}
+
+// Done returns a channel that is closed when the subscription ends, by
+// Close, by the hub's Close, or because the hub's source stopped on its
+// own.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns why the subscription ended: nil while it is attached or if it
+// was ended by Close, a *PanicError if its callback panicked, otherwise the
+// error of the source that stopped, such as one wrapping
+// ErrListenerTerminated.
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+func (s *Subscription) detach() {
+	if s.closed.Swap(true) {
+		return
+	}
+	s.hub.detach(s)
+	s.finish(nil)
+}
+
+// end ends a subscription the hub has already dropped.
+func (s *Subscription) end(err error) {
+	if s.closed.Swap(true) {
+		return
+	}
+	s.finish(err)
+}
+
+func (s *Subscription) finish(err error) {
+	s.err = err
+	close(s.done)
+}
+
+func unblockAll(subs *[]*Subscription) {
+	if subs == nil {
+		return
+	}
+	for _, sub := range *subs {
+		if sub.queue != nil {
+			sub.queue.unblock()
+		}
+	}
+}
+
+func endAll(subs *[]*Subscription, err error) {
+	if subs == nil {
+		return
+	}
+	for _, sub := range *subs {
+		sub.end(err)
+	}
+}
+
+func (h *Hub) attach(sub *Subscription) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return &OpError{Op: "subscribe", Err: ErrListenerClosed}
+	}
+	if h.src == nil {
+		src, err := h.newSource()
+		if err != nil {
+			return err
+		}
+		if err := src.Start(h.dispatch); err != nil {
+			src.Close()
+			return err
+		}
+		h.src = src
+		if d, ok := src.(interface{ Done() <-chan struct{} }); ok {
+			go h.watch(src, d.Done())
+		}
+	}
+	var subs []*Subscription
+	if cur := h.subs.Load(); cur != nil {
+		subs = slices.Clone(*cur)
+	}
+	subs = append(subs, sub)
+	h.subs.Store(&subs)
+	return nil
+}
+
+func (h *Hub) detach(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	cur := h.subs.Load()
+	if cur == nil {
+		return
+	}
+	subs := slices.DeleteFunc(slices.Clone(*cur), func(s *Subscription) bool { return s == sub })
+	h.subs.Store(&subs)
+	if sub.queue != nil {
+		sub.queue.unblock()
+	}
+	if len(subs) == 0 && h.src != nil {
+		h.src.Stop()
+		h.src.Close()
+		h.src = nil
+	}
+}
+
+// watch ends every subscription if src stops on its own while it is still
+// the hub's source, so that subscribers learn why and the next one starts a
+// new source.
+func (h *Hub) watch(src EventSource, done <-chan struct{}) {
+	<-done
+	h.mu.Lock()
+	if h.src != src {
+		h.mu.Unlock()
+		return
+	}
+	h.src = nil
+	subs := h.subs.Swap(nil)
+	h.mu.Unlock()
+
+	var err error
+	if e, ok := src.(interface{ Err() error }); ok {
+		err = e.Err()
+	}
+	src.Close()
+	endAll(subs, err)
+}
+
+// dispatch fans an event out to a snapshot of the subscribers.
+func (h *Hub) dispatch(event KeyEvent) {
+	subs := h.subs.Load()
+	if subs == nil {
+		return
+	}
+	for _, sub := range *subs {
+		if sub.closed.Load() {
+			continue
+		}
+		if sub.queue != nil {
+			sub.queue.push(event)
+		} else {
+			sub.invoke(event)
+		}
+	}
+}
+
+// invoke runs the subscription's callback. A panic ends that subscription
+// with a PanicError; the other subscribers still receive the event.
+func (s *Subscription) invoke(event KeyEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.fail(&PanicError{Value: r, Stack: debug.Stack(), Event: event})
+		}
+	}()
+	s.callback(event)
+}
+
+// fail ends the subscription with err from the source's thread. Detaching
+// may stop the source, which joins that thread, so it happens elsewhere.
+func (s *Subscription) fail(err error) {
+	if s.closed.Swap(true) {
+		return
+	}
+	go func() {
+		s.hub.detach(s)
+		s.finish(err)
+	}()
+}
@@ -0,0 +1,222 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keyboardtest"
+)
+
+// fakeHub returns a hub whose sources are fake listeners, and a function
+// returning the most recent one.
+func fakeHub() (*Hub, func() *keyboardtest.Listener) {
+	var last *keyboardtest.Listener
+	h := NewHub(func() (EventSource, error) {
+		last = keyboardtest.NewListener()
+		return last, nil
+	})
+	return h, func() *keyboardtest.Listener { return last }
+}
+
+// threadSource delivers each event from a goroutine of its own, and its Stop
+// waits for deliveries in progress, as the native listener joins its thread.
+type threadSource struct {
+	mu       sync.Mutex
+	callback ListenerCallback
+	inflight sync.WaitGroup
+}
+
+func (s *threadSource) Start(callback ListenerCallback) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.callback = callback
+	return nil
+}
+
+func (s *threadSource) Stop() {
+	s.inflight.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.callback = nil
+}
+
+func (s *threadSource) IsListening() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.callback != nil
+}
+
+func (s *threadSource) Close() {}
+
+// send delivers event and returns without waiting for the callback.
+func (s *threadSource) send(event KeyEvent) {
+	s.mu.Lock()
+	callback := s.callback
+	s.mu.Unlock()
+	s.inflight.Add(1)
+	go func() {
+		defer s.inflight.Done()
+		callback(event)
+	}()
+}
+
+// blockedHub returns a hub with one OverflowBlock subscriber whose consumer
+// never reads, and a delivery parked on its full buffer.
+func blockedHub(t *testing.T, ctx context.Context) *Hub {
+	t.Helper()
+	src := &threadSource{}
+	h := NewHub(func() (EventSource, error) { return src, nil })
+	ch, err := h.SubscribeEvents(ctx, WithBufferSize(1), WithOverflowPolicy(OverflowBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.send(KeyEvent{Key: KeyA, Pressed: true})
+	for len(ch) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	src.send(KeyEvent{Key: KeyB, Pressed: true})
+	return h
+}
+
+// within fails the test if fn does not return in time.
+func within(t *testing.T, what string, fn func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("%s did not return while a delivery was blocked", what)
+	}
+}
+
+func TestHubCloseUnblocksDelivery(t *testing.T) {
+	h := blockedHub(t, context.Background())
+	within(t, "Close", h.Close)
+}
+
+func TestHubLastSubscriberDetachUnblocksDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h := blockedHub(t, ctx)
+	defer h.Close()
+	within(t, "detach", func() {
+		cancel()
+		for h.IsListening() {
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
+
+func TestHubCallbackPanicEndsOnlyThatSubscription(t *testing.T) {
+	h, source := fakeHub()
+	defer h.Close()
+	bad, err := h.Subscribe(func(KeyEvent) { panic("boom") })
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []Key
+	good, err := h.Subscribe(func(event KeyEvent) { got = append(got, event.Key) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer good.Close()
+
+	source().Press(KeyA)
+	<-bad.Done()
+	var perr *PanicError
+	if !errors.As(bad.Err(), &perr) || perr.Value != "boom" || perr.Event.Key != KeyA {
+		t.Errorf("Err = %v, want PanicError for A", bad.Err())
+	}
+	source().Press(KeyB)
+	if len(got) != 2 || got[0] != KeyA || got[1] != KeyB {
+		t.Errorf("got %v, want [A B]", got)
+	}
+	if h.Subscribers() != 1 || !h.IsListening() {
+		t.Errorf("Subscribers, IsListening = %d, %v; want 1, true", h.Subscribers(), h.IsListening())
+	}
+}
+
+func TestHubEndsSubscriptionsWhenSourceTerminates(t *testing.T) {
+	h, source := fakeHub()
+	sub, err := h.Subscribe(func(KeyEvent) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := h.SubscribeEvents(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := source()
+	first.Terminate(nil)
+
+	<-sub.Done()
+	if !errors.Is(sub.Err(), ErrListenerTerminated) {
+		t.Errorf("Err = %v, want ErrListenerTerminated", sub.Err())
+	}
+	for range ch {
+	}
+	if h.Subscribers() != 0 || h.IsListening() {
+		t.Errorf("Subscribers, IsListening = %d, %v after termination; want 0, false", h.Subscribers(), h.IsListening())
+	}
+
+	var got []Key
+	sub, err = h.Subscribe(func(event KeyEvent) { got = append(got, event.Key) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	if source() == first {
+		t.Fatal("next subscriber reused the terminated source")
+	}
+	source().Press(KeyA)
+	if len(got) != 1 || got[0] != KeyA {
+		t.Errorf("got %v, want [A]", got)
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	h, source := fakeHub()
+	sub, err := h.Subscribe(func(KeyEvent) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Close()
+	h.Close()
+
+	<-sub.Done()
+	if sub.Err() != nil {
+		t.Errorf("Err = %v after hub Close, want nil", sub.Err())
+	}
+	if source().IsListening() {
+		t.Error("source still listening after hub Close")
+	}
+	if _, err := h.Subscribe(func(KeyEvent) {}); !errors.Is(err, ErrListenerClosed) {
+		t.Errorf("Subscribe after Close = %v, want ErrListenerClosed", err)
+	}
+	sub.Close()
+}
+
+func TestSubscriptionCloseEndsWithoutError(t *testing.T) {
+	h, _ := fakeHub()
+	defer h.Close()
+	sub, err := h.Subscribe(func(KeyEvent) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub.Close()
+	<-sub.Done()
+	if sub.Err() != nil {
+		t.Errorf("Err = %v, want nil", sub.Err())
+	}
+	if h.IsListening() {
+		t.Error("hub still listening after last subscriber closed")
+	}
+}
@@ -0,0 +1,12 @@
+package keyboard
+
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+
+// Injector is the behaviour shared by keyboard input injection backends.
+// *Sender satisfies it; alternative backends, decorators and test doubles
+// can implement it to stand in for the native sender.
+type Injector = keytypes.Injector
+
+// EventSource is the behaviour shared by global keyboard event sources.
+// *Listener satisfies it.
+type EventSource = keytypes.EventSource
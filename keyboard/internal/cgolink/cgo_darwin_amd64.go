@@ -0,0 +1,8 @@
+//go:build darwin && amd64
+
+package cgolink
+
+/*
+#cgo LDFLAGS: ${SRCDIR}/../../../lib/macos-x86_64/libaxidev_io.a -lstdc++ -framework ApplicationServices -framework Carbon -framework Foundation -framework CoreGraphics
+*/
+import "C"
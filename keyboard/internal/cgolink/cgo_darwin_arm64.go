@@ -0,0 +1,8 @@
+//go:build darwin && arm64
+
+package cgolink
+
+/*
+#cgo LDFLAGS: ${SRCDIR}/../../../lib/macos-arm64/libaxidev_io.a -lstdc++ -framework ApplicationServices -framework Carbon -framework Foundation -framework CoreGraphics
+*/
+import "C"
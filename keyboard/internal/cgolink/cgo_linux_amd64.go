@@ -0,0 +1,8 @@
+//go:build linux && amd64
+
+package cgolink
+
+/*
+#cgo LDFLAGS: ${SRCDIR}/../../../lib/linux-x64/libaxidev_io.a -lstdc++ -linput -ludev -lxkbcommon -lpthread
+*/
+import "C"
@@ -0,0 +1,8 @@
+//go:build linux && arm64
+
+package cgolink
+
+/*
+#cgo LDFLAGS: ${SRCDIR}/../../../lib/linux-arm64/libaxidev_io.a -lstdc++ -linput -ludev -lxkbcommon -lpthread
+*/
+import "C"
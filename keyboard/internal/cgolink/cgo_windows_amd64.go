@@ -0,0 +1,8 @@
+//go:build windows && amd64
+
+package cgolink
+
+/*
+#cgo windows,amd64 LDFLAGS: -L${SRCDIR}/../../../lib/windows-x64 -laxidev_io -luser32 -lstdc++ -lm
+*/
+import "C"
@@ -0,0 +1,8 @@
+//go:build windows && arm64
+
+package cgolink
+
+/*
+#cgo windows,arm64 LDFLAGS: -L${SRCDIR}/../../../lib/windows-arm64 -laxidev_io -luser32 -lstdc++ -lm
+*/
+import "C"
@@ -0,0 +1,4 @@
+// Package cgolink holds the per-platform linker flags for the native
+// library. It has no API; the keyboard package and genkeys import it for its
+// #cgo LDFLAGS, so the two cannot link against different libraries.
+package cgolink
@@ -0,0 +1,399 @@
+// Command genkeys generates keyboard/keytypes/keys_gen.go and
+// keyboard/keys_gen.go from the native key table.
+//
+// It walks every key id, asks the native library for its canonical name with
+// axidev_io_keyboard_key_to_string, and writes a Go constant for each named
+// key together with the tables behind AllKeys, KeyToString, StringToKey and
+// Key.Category, plus the keyboard package's aliases of those constants.
+// Categories are assigned from the canonical names (see category), so they
+// follow a key if the native table renumbers it.
+// The name lookup table is filled by resolving names with
+// axidev_io_keyboard_string_to_key, so the Go lookup answers as the native
+// one does. The C API cannot list the parser's aliases, so the names probed
+// are the canonical names, every printable ASCII character and the aliases
+// checked in as aliases.txt; generation fails if the parser rejects one of
+// those, so the list cannot go stale unnoticed. The output depends only on
+// the linked library and this directory, not on the toolchain. Run it
+// through go generate in the keyboard package:
+//
+//	go generate ./keyboard
+//
+// With -check it compares the generated sources with the files on disk
+// instead of writing them, and exits non-zero if the constants are out of
+// sync with the linked library.
+package main
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../../include
+#include <axidev-io/c_api.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+
+	_ "github.com/axide-dev/axidev-io-go/keyboard/internal/cgolink"
+)
+
+// symbolNames names keys whose canonical name is a punctuation character.
+var symbolNames = map[string]string{
+	"`": "Grave",
+	"-": "Minus",
+	"=": "Equal",
+	"[": "LeftBracket",
+	"]": "RightBracket",
+	`\`: "Backslash",
+	";": "Semicolon",
+	"'": "Apostrophe",
+	",": "Comma",
+	".": "Period",
+	"/": "Slash",
+}
+
+// aliasList holds the native parser's aliases; see aliases.txt.
+//
+//go:embed aliases.txt
+var aliasList string
+
+// overrides names keys whose canonical name would collide with another key's
+// identifier once capitalised.
+var overrides = map[string]string{
+	"oe": "OESmall",
+}
+
+// namedCategories assigns the keys whose category cannot be told from the
+// shape of their name. Keys matching no rule in category are System keys.
+var namedCategories = map[string]string{
+	"Left": "Navigation", "Right": "Navigation", "Up": "Navigation",
+	"Down": "Navigation", "Home": "Navigation", "End": "Navigation",
+	"PageUp": "Navigation", "PageDown": "Navigation",
+
+	"Enter": "Editing", "Backspace": "Editing", "Tab": "Editing",
+	"Space": "Editing", "Delete": "Editing", "Insert": "Editing",
+	"Cancel": "Editing", "Redo": "Editing", "Undo": "Editing",
+	"Find": "Editing", "Copy": "Editing", "Paste": "Editing", "Cut": "Editing",
+
+	"Mute": "Media", "Eject": "Media",
+
+	"At": "Symbol", "Hashtag": "Symbol", "Exclamation": "Symbol",
+	"Dollar": "Symbol", "Percent": "Symbol", "Caret": "Symbol",
+	"Ampersand": "Symbol", "Asterisk": "Symbol", "LeftParen": "Symbol",
+	"RightParen": "Symbol", "Underscore": "Symbol", "Plus": "Symbol",
+	"Colon": "Symbol", "Quote": "Symbol", "QuestionMark": "Symbol",
+	"Bar": "Symbol", "LessThan": "Symbol", "GreaterThan": "Symbol",
+	"Degree": "Symbol", "Sterling": "Symbol", "Mu": "Symbol",
+	"PlusMinus": "Symbol", "Section": "Symbol", "OE": "Symbol", "oe": "Symbol",
+
+	// ASCII control characters without a key of their own; BS, HT, LF, CR,
+	// ESC and DEL are Backspace, Tab, Enter, Escape and Delete.
+	"NUL": "Control", "SOH": "Control", "STX": "Control", "ETX": "Control",
+	"EOT": "Control", "ENQ": "Control", "ACK": "Control", "Bell": "Control",
+	"VT": "Control", "FF": "Control", "SO": "Control", "SI": "Control",
+	"DLE": "Control", "DC1": "Control", "DC2": "Control", "DC3": "Control",
+	"DC4": "Control", "NAK": "Control", "SYN": "Control", "ETB": "Control",
+	"CAN": "Control", "EM": "Control", "SUB": "Control", "FS": "Control",
+	"GS": "Control", "RS": "Control", "US": "Control",
+}
+
+// category returns the KeyCategory constant suffix for a canonical key name.
+func category(name string) string {
+	if c, ok := namedCategories[name]; ok {
+		return c
+	}
+	r, size := utf8.DecodeRuneInString(name)
+	single := size == len(name)
+	switch {
+	case single && r >= 'A' && r <= 'Z':
+		return "Letter"
+	case single && r >= '0' && r <= '9':
+		return "Digit"
+	case single && (unicode.IsPunct(r) || unicode.IsSymbol(r)):
+		return "Symbol"
+	case len(name) > 1 && name[0] == 'F' && strings.Trim(name[1:], "0123456789") == "":
+		return "Function"
+	case strings.HasPrefix(name, "Numpad"):
+		return "Numpad"
+	case strings.HasPrefix(name, "Media"), strings.HasPrefix(name, "Volume"),
+		strings.HasPrefix(name, "Audio"):
+		return "Media"
+	case strings.HasPrefix(name, "Dead"):
+		return "Symbol"
+	case strings.HasSuffix(name, "Lock"):
+		return "Lock"
+	}
+	for _, mod := range []string{"Shift", "Ctrl", "Alt", "Super"} {
+		if name == mod+"Left" || name == mod+"Right" {
+			return "Modifier"
+		}
+	}
+	return "System"
+}
+
+type key struct {
+	id    uint16
+	name  string
+	ident string
+}
+
+// alias is a lower-case name accepted by the native parser.
+type alias struct {
+	name  string
+	ident string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genkeys: ")
+	out := flag.String("o", "keytypes/keys_gen.go", "output file for the keytypes package")
+	aliasOut := flag.String("aliases", "keys_gen.go", "output file for the keyboard package's aliases")
+	check := flag.Bool("check", false, "verify the output files are up to date instead of writing them")
+	flag.Parse()
+
+	C.axidev_io_log_set_level(C.AXIDEV_IO_LOG_LEVEL_ERROR)
+	keys, err := nativeKeys()
+	if err != nil {
+		log.Fatal(err)
+	}
+	aliases, err := nativeAliases(keys, listedAliases())
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(keys, aliases)
+	if err != nil {
+		log.Fatal(err)
+	}
+	aliasSrc, err := generateAliases(keys)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, f := range []struct {
+		name string
+		src  []byte
+	}{{*out, src}, {*aliasOut, aliasSrc}} {
+		if *check {
+			cur, err := os.ReadFile(f.name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !bytes.Equal(cur, f.src) {
+				log.Fatalf("%s is out of date with the native key table; run go generate", f.name)
+			}
+			continue
+		}
+		if err := os.WriteFile(f.name, f.src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// keyToString returns the native canonical name of id.
+func keyToString(id uint16) string {
+	cStr := C.axidev_io_keyboard_key_to_string(C.axidev_io_keyboard_key_t(id))
+	if cStr == nil {
+		return ""
+	}
+	defer C.axidev_io_free_string(cStr)
+	return C.GoString(cStr)
+}
+
+// stringToKey returns the native parse of name.
+func stringToKey(name string) uint16 {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return uint16(C.axidev_io_keyboard_string_to_key(cName))
+}
+
+// nativeKeys returns every key with a canonical name, in id order.
+func nativeKeys() ([]key, error) {
+	unknown := keyToString(0)
+	seen := make(map[string]uint16)
+	var keys []key
+	for i := 1; i <= math.MaxUint16; i++ {
+		id := uint16(i)
+		name := keyToString(id)
+		if name == "" || name == unknown {
+			continue
+		}
+		ident, err := identifier(name)
+		if err != nil {
+			return nil, err
+		}
+		if prev, dup := seen[ident]; dup {
+			return nil, fmt.Errorf("keys %d and %d both map to Key%s; add an override", prev, id, ident)
+		}
+		seen[ident] = id
+		keys = append(keys, key{id: id, name: name, ident: "Key" + ident})
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("native key table is empty")
+	}
+	return keys, nil
+}
+
+// listedAliases returns the names in aliases.txt, skipping blank lines and
+// comments.
+func listedAliases() []string {
+	var names []string
+	for line := range strings.Lines(aliasList) {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			names = append(names, line)
+		}
+	}
+	return names
+}
+
+// nativeAliases resolves the canonical names, every printable ASCII
+// character and the listed aliases with the native parser, and returns
+// those it accepts, sorted by name. It fails if a listed alias is rejected.
+func nativeAliases(keys []key, listed []string) ([]alias, error) {
+	idents := make(map[uint16]string, len(keys))
+	canonical := make(map[string]bool, len(keys))
+	var names []string
+	for _, k := range keys {
+		idents[k.id] = k.ident
+		canonical[k.name] = true
+		names = append(names, strings.ToLower(k.name))
+	}
+	for c := ' '; c < unicode.MaxASCII; c++ {
+		names = append(names, strings.ToLower(string(c)))
+	}
+	required := make(map[string]bool, len(listed))
+	for _, name := range listed {
+		if name != strings.ToLower(name) {
+			return nil, fmt.Errorf("aliases.txt: %q is not in lower case", name)
+		}
+		required[name] = true
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	var aliases []alias
+	for _, name := range names {
+		// The native parser tries an exact canonical match before folding
+		// case; probe a spelling that is not canonical so the result is the
+		// case-insensitive one that StringToKey answers with.
+		probe := name
+		for _, v := range []string{titleCase(name), name} {
+			if !canonical[v] {
+				probe = v
+				break
+			}
+		}
+		id := stringToKey(probe)
+		if id == 0 {
+			if required[name] {
+				return nil, fmt.Errorf("aliases.txt: the native parser rejects %q; remove it", name)
+			}
+			continue
+		}
+		aliases = append(aliases, alias{name: name, ident: idents[id]})
+	}
+	return aliases, nil
+}
+
+func titleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// identifier turns a canonical key name into the suffix of its constant.
+func identifier(name string) (string, error) {
+	if s, ok := overrides[name]; ok {
+		return s, nil
+	}
+	if s, ok := symbolNames[name]; ok {
+		return s, nil
+	}
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_':
+		case i == 0 && unicode.IsLower(r):
+			b.WriteRune(unicode.ToUpper(r))
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		default:
+			return "", fmt.Errorf("no identifier for key name %q; add it to symbolNames", name)
+		}
+	}
+	return b.String(), nil
+}
+
+func generate(keys []key, aliases []alias) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by genkeys from the native key table. DO NOT EDIT.\n\n")
+	b.WriteString("package keytypes\n\n")
+	b.WriteString("// Key constants matching the native key table. Each constant's canonical\n")
+	b.WriteString("// name, as returned by KeyToString, is noted beside it.\n")
+	b.WriteString("const (\n")
+	b.WriteString("\tKeyUnknown Key = 0\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%s Key = %d // %q\n", k.ident, k.id, k.name)
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("// allKeys lists every named key in id order.\n")
+	b.WriteString("var allKeys = [...]Key{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%s,\n", k.ident)
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// keyNames holds the canonical name of every named key, indexed by key.\n")
+	b.WriteString("var keyNames = [...]string{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%s: %q,\n", k.ident, k.name)
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// keyCategories holds the category of every named key, indexed by key.\n")
+	b.WriteString("var keyCategories = [...]KeyCategory{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%s: Category%s,\n", k.ident, category(k.name))
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// keyLookup maps lower-case key names and aliases to the key the native\n")
+	b.WriteString("// parser resolves them to when matching case-insensitively.\n")
+	b.WriteString("var keyLookup = map[string]Key{\n")
+	for _, a := range aliases {
+		fmt.Fprintf(&b, "\t%q: %s,\n", a.name, a.ident)
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// generateAliases writes the keyboard package's aliases of the constants.
+func generateAliases(keys []key) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by genkeys from the native key table. DO NOT EDIT.\n\n")
+	b.WriteString("package keyboard\n\n")
+	b.WriteString("import \"github.com/axide-dev/axidev-io-go/keyboard/keytypes\"\n\n")
+	b.WriteString("// Key constants matching the native key table, from keytypes. Each\n")
+	b.WriteString("// constant's canonical name, as returned by KeyToString, is noted beside it.\n")
+	b.WriteString("const (\n")
+	b.WriteString("\tKeyUnknown = keytypes.KeyUnknown\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%s = keytypes.%s // %q\n", k.ident, k.ident, k.name)
+	}
+	b.WriteString(")\n")
+	return format.Source(b.Bytes())
+}
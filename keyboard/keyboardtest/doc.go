@@ -0,0 +1,24 @@
+// Package keyboardtest provides in-memory fakes of the keyboard package's
+// Injector and EventSource interfaces for use in tests.
+//
+// The package depends only on keytypes, so the fakes neither link nor touch
+// the native library. Code written against the Injector and EventSource
+// interfaces, imported from keytypes (keyboard re-exports them), can be
+// exercised with plain go test on a headless machine:
+//
+//	sender := keyboardtest.NewSender()
+//	runAutomation(sender) // accepts a keyboard.Injector
+//
+//	for _, call := range sender.Calls() {
+//	    fmt.Println(call.Op, call.Key, call.Mods)
+//	}
+//	if len(sender.HeldKeys()) != 0 {
+//	    t.Error("automation left keys held")
+//	}
+//
+// A fake Listener delivers events synchronously to the started callback:
+//
+//	listener := keyboardtest.NewListener()
+//	watchHotkeys(listener) // calls listener.Start
+//	listener.Combo(keyboard.ModCtrl, keyboard.KeyS)
+package keyboardtest
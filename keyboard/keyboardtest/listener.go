@@ -0,0 +1,270 @@
+package keyboardtest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Listener is an in-memory keyboard.EventSource whose events are driven by
+// test code through Emit, Press, Release and Tap.
+//
+// Unlike the native listener, callbacks run synchronously on the goroutine
+// that drives the event, which keeps tests deterministic.
+type Listener struct {
+	mu       sync.Mutex
+	callback keytypes.ListenerCallback
+	held     map[keytypes.Key]time.Time
+	mods     keytypes.Modifier
+	modKeys  keytypes.ModifierKeys
+	closed   bool
+	paused   bool
+	done     chan struct{}
+	err      error
+	seq      uint64
+	now      func() time.Time
+}
+
+var _ keytypes.EventSource = (*Listener)(nil)
+
+// NewListener creates a fake Listener that is not yet listening.
+func NewListener() *Listener {
+	done := make(chan struct{})
+	close(done)
+	return &Listener{held: make(map[keytypes.Key]time.Time), done: done, now: time.Now}
+}
+
+// SetClock replaces the clock used to timestamp events produced by Press,
+// Release and friends, so tests can control event timing. A nil now
+// restores time.Now.
+func (l *Listener) SetClock(now func() time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now == nil {
+		now = time.Now
+	}
+	l.now = now
+}
+
+// Start begins delivering emitted events to callback.
+func (l *Listener) Start(callback keytypes.ListenerCallback) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return &keytypes.OpError{Op: "start", Err: keytypes.ErrListenerClosed}
+	}
+	if callback == nil {
+		return &keytypes.OpError{Op: "start", Err: keytypes.ErrNilCallback}
+	}
+	if l.callback != nil {
+		return &keytypes.OpError{Op: "start", Err: keytypes.ErrAlreadyListening}
+	}
+	l.callback = callback
+	l.paused = false
+	l.done = make(chan struct{})
+	l.err = nil
+	return nil
+}
+
+// Stop stops delivering events. Events emitted while stopped are dropped.
+func (l *Listener) Stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.endLocked(nil)
+}
+
+// Terminate ends the listening session as if the native listener had died,
+// so that Done is closed and Err returns err. A nil err reports an
+// *keyboard.OpError wrapping keyboard.ErrListenerTerminated. No-op if not
+// running.
+func (l *Listener) Terminate(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err == nil {
+		err = &keytypes.OpError{Op: "listen", Err: keytypes.ErrListenerTerminated}
+	}
+	l.endLocked(err)
+}
+
+// IsListening returns true between Start and Stop, including while paused.
+func (l *Listener) IsListening() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.callback != nil
+}
+
+// Pause stops delivering events without ending the session. Events emitted
+// while paused are dropped.
+func (l *Listener) Pause() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.paused = l.callback != nil
+}
+
+// Resume resumes delivering events after Pause.
+func (l *Listener) Resume() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.paused = false
+}
+
+// IsPaused returns true if the listener is running but paused.
+func (l *Listener) IsPaused() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.paused
+}
+
+// Done returns a channel that is closed when the current session ends.
+// Before the first Start it returns a closed channel.
+func (l *Listener) Done() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.done
+}
+
+// Err returns the error the most recent session ended with, or nil if it
+// is running or was ended by Stop or Close.
+func (l *Listener) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// Close stops the listener permanently. Safe to call multiple times.
+func (l *Listener) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.endLocked(nil)
+	l.closed = true
+}
+
+// endLocked ends the current session with err. l.mu must be held.
+func (l *Listener) endLocked(err error) {
+	if l.callback == nil {
+		return
+	}
+	l.callback = nil
+	l.paused = false
+	l.err = err
+	close(l.done)
+}
+
+// Modifiers returns the simulated modifier state seen by the listener, as
+// reported in KeyEvent.Modifiers.
+func (l *Listener) Modifiers() keytypes.Modifier {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	mods, _ := report(l.mods, l.modKeys)
+	return mods
+}
+
+// Sides returns the side-specific bits of the modifier keys held, as
+// reported in KeyEvent.Sides.
+func (l *Listener) Sides() keytypes.Modifier {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, sides := report(l.mods, l.modKeys)
+	return sides
+}
+
+// SetModifierKeys assigns the keys reported as the extended modifiers, as
+// keyboard.Listener.SetModifierKeys does. It also selects the keys Combo
+// presses for them.
+func (l *Listener) SetModifierKeys(mk keytypes.ModifierKeys) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.modKeys = mk
+}
+
+// Emit delivers event to the callback. A zero Time or Seq is filled in from
+// the listener's clock and sequence; other fields are delivered as-is. It
+// returns false if the listener is not running or is paused and the event
+// was dropped.
+func (l *Listener) Emit(event keytypes.KeyEvent) bool {
+	l.mu.Lock()
+	cb := l.callback
+	if l.paused {
+		cb = nil
+	}
+	if cb != nil {
+		if event.Time.IsZero() {
+			event.Time = l.now()
+		}
+		if event.Seq == 0 {
+			l.seq++
+			event.Seq = l.seq
+		}
+	}
+	l.mu.Unlock()
+	if cb == nil {
+		return false
+	}
+	cb(event)
+	return true
+}
+
+// Press emits a press event for key, with the simulated modifier state
+// updated first so that pressing a modifier key reports its own bit.
+func (l *Listener) Press(key keytypes.Key) bool {
+	return l.Emit(l.track(key, true, 0))
+}
+
+// Release emits a release event for key.
+func (l *Listener) Release(key keytypes.Key) bool {
+	return l.Emit(l.track(key, false, 0))
+}
+
+// PressRune emits a press event for key that produces codepoint.
+func (l *Listener) PressRune(key keytypes.Key, codepoint rune) bool {
+	return l.Emit(l.track(key, true, codepoint))
+}
+
+// Tap emits a press followed by a release of key.
+func (l *Listener) Tap(key keytypes.Key) bool {
+	return l.Press(key) && l.Release(key)
+}
+
+// Combo emits the press and release events a user would produce by holding
+// the modifier keys for mods (see keyboard.ModifierKeys.Keys), tapping key,
+// and releasing the modifiers.
+func (l *Listener) Combo(mods keytypes.Modifier, key keytypes.Key) bool {
+	l.mu.Lock()
+	keys := l.modKeys.Keys(mods)
+	l.mu.Unlock()
+	ok := true
+	for _, k := range keys {
+		ok = l.Press(k) && ok
+	}
+	ok = l.Tap(key) && ok
+	for i := len(keys) - 1; i >= 0; i-- {
+		ok = l.Release(keys[i]) && ok
+	}
+	return ok
+}
+
+// track updates the simulated key state and builds the event to emit.
+func (l *Listener) track(key keytypes.Key, pressed bool, codepoint rune) keytypes.KeyEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	event := keytypes.KeyEvent{
+		Codepoint: uint32(codepoint),
+		Key:       key,
+		Pressed:   pressed,
+		Time:      l.now(),
+	}
+	since, down := l.held[key]
+	if pressed {
+		if !down {
+			l.held[key] = event.Time
+			l.mods = applyPress(l.mods, key, l.modKeys)
+		}
+	} else if down {
+		delete(l.held, key)
+		l.mods = applyRelease(l.mods, l.held, key, l.modKeys)
+		event.HoldDuration = event.Time.Sub(since)
+	}
+	event.Modifiers, event.Sides = report(l.mods, l.modKeys)
+	return event
+}
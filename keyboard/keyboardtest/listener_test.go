@@ -0,0 +1,148 @@
+package keyboardtest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// collect starts l with a callback that appends to the returned slice.
+func collect(t *testing.T, l *Listener) *[]keytypes.KeyEvent {
+	t.Helper()
+	var events []keytypes.KeyEvent
+	if err := l.Start(func(e keytypes.KeyEvent) { events = append(events, e) }); err != nil {
+		t.Fatal(err)
+	}
+	return &events
+}
+
+func TestListenerLifecycle(t *testing.T) {
+	l := NewListener()
+	select {
+	case <-l.Done():
+	default:
+		t.Error("Done is open before Start")
+	}
+	if err := l.Start(nil); !errors.Is(err, keytypes.ErrNilCallback) {
+		t.Errorf("Start(nil) = %v, want ErrNilCallback", err)
+	}
+	collect(t, l)
+	if err := l.Start(func(keytypes.KeyEvent) {}); !errors.Is(err, keytypes.ErrAlreadyListening) {
+		t.Errorf("second Start = %v, want ErrAlreadyListening", err)
+	}
+	done := l.Done()
+	l.Stop()
+	<-done
+	if l.IsListening() || l.Err() != nil {
+		t.Errorf("after Stop: listening %v, Err %v; want false, nil", l.IsListening(), l.Err())
+	}
+
+	events := collect(t, l)
+	l.Terminate(nil)
+	if err := l.Err(); !errors.Is(err, keytypes.ErrListenerTerminated) {
+		t.Errorf("Err after Terminate = %v, want ErrListenerTerminated", err)
+	}
+	if l.Tap(keytypes.KeyA) || len(*events) != 0 {
+		t.Errorf("events delivered after Terminate: %v", *events)
+	}
+
+	collect(t, l)
+	l.Close()
+	l.Close()
+	if err := l.Start(func(keytypes.KeyEvent) {}); !errors.Is(err, keytypes.ErrListenerClosed) {
+		t.Errorf("Start after Close = %v, want ErrListenerClosed", err)
+	}
+}
+
+func TestListenerPause(t *testing.T) {
+	l := NewListener()
+	events := collect(t, l)
+	l.Pause()
+	if !l.IsListening() || !l.IsPaused() {
+		t.Errorf("paused: listening %v, paused %v; want true, true", l.IsListening(), l.IsPaused())
+	}
+	if l.Tap(keytypes.KeyA) {
+		t.Error("Tap delivered while paused")
+	}
+	l.Resume()
+	if !l.Tap(keytypes.KeyB) || len(*events) != 2 || (*events)[0].Key != keytypes.KeyB {
+		t.Errorf("events after Resume = %v, want B pressed and released", *events)
+	}
+	l.Stop()
+	l.Pause()
+	if l.IsPaused() {
+		t.Error("Pause took effect on a stopped listener")
+	}
+}
+
+func TestListenerModifiers(t *testing.T) {
+	l := NewListener()
+	events := collect(t, l)
+	l.Press(keytypes.KeyShiftLeft)
+	l.Press(keytypes.KeyShiftRight)
+	l.Release(keytypes.KeyShiftLeft)
+	got := (*events)[len(*events)-1]
+	if got.Modifiers != keytypes.ModShift || got.Sides != keytypes.ModRightShift {
+		t.Errorf("after releasing one Shift: %v, sides %v; want Shift, RightShift", got.Modifiers, got.Sides)
+	}
+	l.Release(keytypes.KeyShiftRight)
+	if l.Modifiers() != 0 || l.Sides() != 0 {
+		t.Errorf("after releasing both: %v, sides %v; want None", l.Modifiers(), l.Sides())
+	}
+
+	l.Tap(keytypes.KeyCapsLock)
+	if l.Modifiers() != keytypes.ModCapsLock {
+		t.Errorf("after tapping CapsLock: %v, want CapsLock", l.Modifiers())
+	}
+	l.Tap(keytypes.KeyCapsLock)
+	if l.Modifiers() != 0 {
+		t.Errorf("after tapping CapsLock twice: %v, want None", l.Modifiers())
+	}
+}
+
+func TestListenerModifierKeys(t *testing.T) {
+	l := NewListener()
+	l.SetModifierKeys(keytypes.ModifierKeys{AltGr: keytypes.KeyAltRight})
+	events := collect(t, l)
+	if !l.Combo(keytypes.ModAltGr, keytypes.KeyE) {
+		t.Fatal("Combo dropped events")
+	}
+	var press keytypes.KeyEvent
+	for _, e := range *events {
+		if e.Key == keytypes.KeyE && e.Pressed {
+			press = e
+		}
+	}
+	if press.Modifiers != keytypes.ModAltGr || press.Sides != 0 {
+		t.Errorf("E pressed with %v, sides %v; want AltGr and no sides", press.Modifiers, press.Sides)
+	}
+	if first := (*events)[0]; first.Key != keytypes.KeyAltRight || !first.Pressed {
+		t.Errorf("first event = %+v, want AltRight pressed", first)
+	}
+}
+
+func TestListenerTiming(t *testing.T) {
+	l := NewListener()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	l.SetClock(func() time.Time { return now })
+	events := collect(t, l)
+	l.Press(keytypes.KeyA)
+	now = now.Add(80 * time.Millisecond)
+	l.Release(keytypes.KeyA)
+	l.Emit(keytypes.KeyEvent{Key: keytypes.KeyB, Pressed: true, Seq: 100})
+
+	if len(*events) != 3 {
+		t.Fatalf("got %d events, want 3", len(*events))
+	}
+	up := (*events)[1]
+	if up.HoldDuration != 80*time.Millisecond || !up.Time.Equal(now) {
+		t.Errorf("release: hold %v at %v; want 80ms at %v", up.HoldDuration, up.Time, now)
+	}
+	for i, want := range []uint64{1, 2, 100} {
+		if got := (*events)[i].Seq; got != want {
+			t.Errorf("event %d Seq = %d, want %d", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package keyboardtest
+
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+
+// sideKeys are the eight modifier keys that have a side-specific bit.
+var sideKeys = []keytypes.Key{
+	keytypes.KeyCtrlLeft,
+	keytypes.KeyCtrlRight,
+	keytypes.KeyAltLeft,
+	keytypes.KeyAltRight,
+	keytypes.KeyShiftLeft,
+	keytypes.KeyShiftRight,
+	keytypes.KeySuperLeft,
+	keytypes.KeySuperRight,
+}
+
+// applyPress returns mods updated for key going down. Extended bits of
+// modifier keys are left to report, as they follow from the side bits.
+func applyPress(mods keytypes.Modifier, key keytypes.Key, mk keytypes.ModifierKeys) keytypes.Modifier {
+	if key.SideModifierBit() == 0 {
+		mods |= mk.Bits(key)
+	}
+	bit := key.ModifierBit()
+	if bit&keytypes.LockModifiers != 0 {
+		return mods ^ bit
+	}
+	return mods | bit | key.SideModifierBit()
+}
+
+// applyRelease returns mods updated for key going up, given the keys still
+// held after the release. A held modifier stays active while either of its
+// keys is down.
+func applyRelease[V any](mods keytypes.Modifier, held map[keytypes.Key]V, key keytypes.Key, mk keytypes.ModifierKeys) keytypes.Modifier {
+	if key.SideModifierBit() == 0 {
+		mods &^= mk.Bits(key)
+	}
+	bit := key.ModifierBit()
+	if bit == 0 || bit&keytypes.LockModifiers != 0 {
+		return mods
+	}
+	mods &^= key.SideModifierBit()
+	for k := range held {
+		if k.ModifierBit() == bit {
+			return mods
+		}
+	}
+	return mods &^ bit
+}
+
+// releaseMods returns mods with rel released. A generic bit releases the
+// modifier on both sides; a side-specific bit releases that side, and the
+// generic bit too once neither side remains. Extended bits are cleared
+// as given.
+func releaseMods(mods, rel keytypes.Modifier) keytypes.Modifier {
+	for _, k := range sideKeys {
+		if rel&(k.ModifierBit()|k.SideModifierBit()) != 0 {
+			mods &^= k.SideModifierBit()
+		}
+	}
+	for _, k := range sideKeys {
+		generic := k.ModifierBit()
+		if rel&generic != 0 || (rel&k.SideModifierBit() != 0 && !holdsSide(mods, generic)) {
+			mods &^= generic
+		}
+	}
+	return mods &^ (rel & (keytypes.LockModifiers | keytypes.ExtendedModifiers))
+}
+
+// report splits the simulated modifier state into the Modifiers and Sides
+// of an event, reporting the keys assigned in mk as their extended bits.
+func report(mods keytypes.Modifier, mk keytypes.ModifierKeys) (keytypes.Modifier, keytypes.Modifier) {
+	return mk.Apply(mods&^keytypes.SideModifiers, mods&keytypes.SideModifiers)
+}
+
+// holdsSide reports whether mods has a side-specific bit for generic.
+func holdsSide(mods, generic keytypes.Modifier) bool {
+	for _, k := range sideKeys {
+		if k.ModifierBit() == generic && mods&k.SideModifierBit() != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,327 @@
+package keyboardtest
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+// Op identifies the Sender method that produced a recorded Call.
+type Op uint8
+
+// Recorded operations.
+const (
+	OpKeyDown Op = iota + 1
+	OpKeyUp
+	OpTap
+	OpCombo
+	OpTypeText
+	OpTypeCharacter
+	OpHoldModifier
+	OpReleaseModifier
+	OpReleaseAllModifiers
+	OpFlush
+)
+
+var opNames = [...]string{
+	OpKeyDown:             "KeyDown",
+	OpKeyUp:               "KeyUp",
+	OpTap:                 "Tap",
+	OpCombo:               "Combo",
+	OpTypeText:            "TypeText",
+	OpTypeCharacter:       "TypeCharacter",
+	OpHoldModifier:        "HoldModifier",
+	OpReleaseModifier:     "ReleaseModifier",
+	OpReleaseAllModifiers: "ReleaseAllModifiers",
+	OpFlush:               "Flush",
+}
+
+// String returns the name of the Sender method the operation records.
+func (o Op) String() string {
+	if int(o) < len(opNames) && opNames[o] != "" {
+		return opNames[o]
+	}
+	return "Op(" + strconv.Itoa(int(o)) + ")"
+}
+
+// opName returns the operation name used in keyboard.OpError for o.
+func (o Op) opName() string {
+	var b []byte
+	for i, r := range o.String() {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b = append(b, ' ')
+			}
+			r += 'a' - 'A'
+		}
+		b = append(b, byte(r))
+	}
+	return string(b)
+}
+
+// Call is a single recorded Sender operation.
+type Call struct {
+	// Op is the method that was called.
+	Op Op
+
+	// Key is the key argument (KeyDown, KeyUp, Tap, Combo).
+	Key keytypes.Key
+
+	// Mods is the modifier argument (Combo, HoldModifier, ReleaseModifier).
+	Mods keytypes.Modifier
+
+	// Text is the text argument (TypeText).
+	Text string
+
+	// Codepoint is the codepoint argument (TypeCharacter).
+	Codepoint rune
+
+	// Err is the error returned to the caller, if any.
+	Err error
+}
+
+// Sender is an in-memory keyboard.Injector that records every call as a
+// timeline and simulates held keys and modifiers.
+//
+// The zero value is not usable; create instances with NewSender.
+type Sender struct {
+	mu       sync.Mutex
+	calls    []Call
+	held     map[keytypes.Key]bool
+	mods     keytypes.Modifier
+	modKeys  keytypes.ModifierKeys
+	failures map[Op]error
+	closed   bool
+}
+
+var _ keytypes.Injector = (*Sender)(nil)
+
+// NewSender creates a fake Sender with no keys held.
+func NewSender() *Sender {
+	return &Sender{
+		held:     make(map[keytypes.Key]bool),
+		failures: make(map[Op]error),
+	}
+}
+
+// FailWith makes every subsequent call of op return err without changing the
+// simulated state. Passing a nil err clears the failure. Use a
+// *keyboard.OpError wrapping one of the keyboard sentinel errors to mimic
+// the native backend.
+func (s *Sender) FailWith(op Op, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.failures, op)
+		return
+	}
+	s.failures[op] = err
+}
+
+// Calls returns a copy of the recorded timeline.
+func (s *Sender) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.calls)
+}
+
+// Reset clears the recorded timeline, held keys and modifiers, and failures.
+func (s *Sender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+	clear(s.held)
+	clear(s.failures)
+	s.mods = 0
+}
+
+// HeldKeys returns the keys currently held down, in ascending order.
+func (s *Sender) HeldKeys() []keytypes.Key {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]keytypes.Key, 0, len(s.held))
+	for k := range s.held {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// IsHeld returns true if key is currently held down.
+func (s *Sender) IsHeld(key keytypes.Key) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.held[key]
+}
+
+// Text returns the concatenation of all successfully typed text and
+// characters, in call order.
+func (s *Sender) Text() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var b []rune
+	for _, c := range s.calls {
+		if c.Err != nil {
+			continue
+		}
+		switch c.Op {
+		case OpTypeText:
+			b = append(b, []rune(c.Text)...)
+		case OpTypeCharacter:
+			b = append(b, c.Codepoint)
+		}
+	}
+	return string(b)
+}
+
+// Close marks the sender closed. Safe to call multiple times.
+func (s *Sender) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+}
+
+// KeyDown records a key press and marks key as held.
+func (s *Sender) KeyDown(key keytypes.Key) error {
+	return s.do(Call{Op: OpKeyDown, Key: key}, func() { s.press(key) })
+}
+
+// KeyUp records a key release and marks key as no longer held.
+func (s *Sender) KeyUp(key keytypes.Key) error {
+	return s.do(Call{Op: OpKeyUp, Key: key}, func() { s.release(key) })
+}
+
+// Tap records a key tap. The key is not left held.
+func (s *Sender) Tap(key keytypes.Key) error {
+	return s.do(Call{Op: OpTap, Key: key}, func() {
+		s.press(key)
+		s.release(key)
+	})
+}
+
+// SendChord records the chord as a Combo call.
+func (s *Sender) SendChord(c keytypes.Chord) error {
+	return s.Combo(c.Mods, c.Key)
+}
+
+// Combo records a key combo. Neither the modifiers nor the key are left
+// held, and modifiers that were already held stay held. It fails with
+// keyboard.ErrNoMapping for an unassigned extended bit, as HoldModifier does.
+func (s *Sender) Combo(mods keytypes.Modifier, key keytypes.Key) error {
+	return s.do(Call{Op: OpCombo, Key: key, Mods: mods}, func() {
+		prev := s.mods
+		s.mods |= mods.Normalize()
+		s.press(key)
+		s.release(key)
+		s.mods = prev | (s.mods & keytypes.LockModifiers)
+	})
+}
+
+// TypeText records injected text.
+func (s *Sender) TypeText(text string) error {
+	return s.do(Call{Op: OpTypeText, Text: text}, nil)
+}
+
+// TypeCharacter records an injected codepoint.
+func (s *Sender) TypeCharacter(codepoint rune) error {
+	return s.do(Call{Op: OpTypeCharacter, Codepoint: codepoint}, nil)
+}
+
+// HoldModifier records the call and marks mods as active. Side-specific
+// bits also set their generic bit. Like keyboard.Sender.HoldModifier, it
+// fails with keyboard.ErrNoMapping if mods has an extended bit with no key
+// assigned by SetModifierKeys.
+func (s *Sender) HoldModifier(mods keytypes.Modifier) error {
+	return s.do(Call{Op: OpHoldModifier, Mods: mods}, func() { s.mods |= mods.Normalize() })
+}
+
+// ReleaseModifier records the call and clears mods from the active set. A
+// generic bit clears both sides; a side-specific bit clears that side, and
+// the generic bit once neither side remains.
+func (s *Sender) ReleaseModifier(mods keytypes.Modifier) error {
+	return s.do(Call{Op: OpReleaseModifier, Mods: mods}, func() { s.mods = releaseMods(s.mods, mods) })
+}
+
+// ReleaseAllModifiers records the call and clears every held modifier.
+// Lock modifiers (CapsLock, NumLock) keep their state.
+func (s *Sender) ReleaseAllModifiers() error {
+	return s.do(Call{Op: OpReleaseAllModifiers}, func() {
+		for k := range s.held {
+			if k.ModifierBit() != 0 {
+				delete(s.held, k)
+			}
+		}
+		s.mods &= keytypes.LockModifiers
+	})
+}
+
+// ActiveModifiers returns the simulated modifier state, without
+// side-specific bits.
+func (s *Sender) ActiveModifiers() keytypes.Modifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	mods, _ := report(s.mods, s.modKeys)
+	return mods
+}
+
+// ActiveSides returns the side-specific bits of the simulated modifier
+// state.
+func (s *Sender) ActiveSides() keytypes.Modifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, sides := report(s.mods, s.modKeys)
+	return sides
+}
+
+// SetModifierKeys assigns the keys reported as the extended modifiers by
+// ActiveModifiers, as keyboard.Sender.SetModifierKeys does. It is not
+// recorded as a call.
+func (s *Sender) SetModifierKeys(mk keytypes.ModifierKeys) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.modKeys = mk
+}
+
+// Flush records the call.
+func (s *Sender) Flush() {
+	_ = s.do(Call{Op: OpFlush}, nil)
+}
+
+// do records c and, if the call is allowed to succeed, applies fn to the
+// simulated state with s.mu held.
+func (s *Sender) do(c Call, fn func()) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case s.closed:
+		c.Err = &keytypes.OpError{Op: c.Op.opName(), Key: c.Key, Codepoint: c.Codepoint, Err: keytypes.ErrSenderClosed}
+	case s.failures[c.Op] != nil:
+		c.Err = s.failures[c.Op]
+	case (c.Op == OpHoldModifier || c.Op == OpCombo) && s.modKeys.Unassigned(c.Mods) != 0:
+		c.Err = &keytypes.OpError{Op: c.Op.opName(), Err: keytypes.ErrNoMapping}
+	case fn != nil:
+		fn()
+	}
+	s.calls = append(s.calls, c)
+	return c.Err
+}
+
+// press marks key held and updates the modifier state. s.mu must be held.
+func (s *Sender) press(key keytypes.Key) {
+	if s.held[key] {
+		return
+	}
+	s.held[key] = true
+	s.mods = applyPress(s.mods, key, s.modKeys)
+}
+
+// release marks key released and updates the modifier state. s.mu must be held.
+func (s *Sender) release(key keytypes.Key) {
+	if !s.held[key] {
+		return
+	}
+	delete(s.held, key)
+	s.mods = applyRelease(s.mods, s.held, key, s.modKeys)
+}
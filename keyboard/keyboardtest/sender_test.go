@@ -0,0 +1,140 @@
+package keyboardtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+)
+
+func TestSenderHoldUnassignedExtended(t *testing.T) {
+	s := NewSender()
+	err := s.HoldModifier(keytypes.ModAltGr | keytypes.ModCtrl)
+	var oe *keytypes.OpError
+	if !errors.As(err, &oe) || oe.Op != "hold modifier" || !errors.Is(err, keytypes.ErrNoMapping) {
+		t.Fatalf("HoldModifier(AltGr|Ctrl) = %v, want an OpError wrapping ErrNoMapping", err)
+	}
+	if got := s.ActiveModifiers(); got != 0 {
+		t.Errorf("ActiveModifiers after failed hold = %v, want None", got)
+	}
+	if err := s.Combo(keytypes.ModMeta, keytypes.KeyX); !errors.Is(err, keytypes.ErrNoMapping) {
+		t.Errorf("Combo(Meta, X) = %v, want ErrNoMapping", err)
+	}
+	if calls := s.Calls(); len(calls) != 2 || calls[0].Err == nil || calls[1].Err == nil {
+		t.Errorf("calls = %+v, want both recorded with their errors", calls)
+	}
+	if s.IsHeld(keytypes.KeyX) {
+		t.Error("X held after a failed Combo")
+	}
+
+	s.SetModifierKeys(keytypes.ModifierKeys{AltGr: keytypes.KeyAltRight})
+	if err := s.HoldModifier(keytypes.ModAltGr); err != nil {
+		t.Fatalf("HoldModifier(AltGr) with a key assigned: %v", err)
+	}
+	if got := s.ActiveModifiers(); got != keytypes.ModAltGr {
+		t.Errorf("ActiveModifiers = %v, want AltGr", got)
+	}
+}
+
+func TestSenderComboKeepsHeldModifiers(t *testing.T) {
+	s := NewSender()
+	if err := s.HoldModifier(keytypes.ModLeftShift); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Combo(keytypes.ModShift|keytypes.ModCtrl, keytypes.KeyA); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ActiveModifiers(); got != keytypes.ModShift {
+		t.Errorf("ActiveModifiers after Combo = %v, want Shift", got)
+	}
+	if got := s.ActiveSides(); got != keytypes.ModLeftShift {
+		t.Errorf("ActiveSides after Combo = %v, want LeftShift", got)
+	}
+	if keys := s.HeldKeys(); len(keys) != 0 {
+		t.Errorf("HeldKeys after Combo = %v, want none", keys)
+	}
+}
+
+func TestSenderReleaseModifier(t *testing.T) {
+	tests := []struct {
+		name             string
+		held, release    keytypes.Modifier
+		wantMods, wantSd keytypes.Modifier
+	}{
+		{"generic releases both sides", keytypes.ModLeftShift | keytypes.ModRightShift, keytypes.ModShift, 0, 0},
+		{"side keeps the other side", keytypes.ModLeftShift | keytypes.ModRightShift, keytypes.ModRightShift, keytypes.ModShift, keytypes.ModLeftShift},
+		{"last side drops generic", keytypes.ModLeftCtrl, keytypes.ModLeftCtrl, 0, 0},
+		{"other modifiers stay", keytypes.ModAlt | keytypes.ModRightSuper, keytypes.ModSuper, keytypes.ModAlt, 0},
+		{"locks release as given", keytypes.ModCapsLock | keytypes.ModNumLock, keytypes.ModCapsLock, keytypes.ModNumLock, 0},
+	}
+	for _, tt := range tests {
+		s := NewSender()
+		if err := s.HoldModifier(tt.held); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.ReleaseModifier(tt.release); err != nil {
+			t.Fatal(err)
+		}
+		if mods, sides := s.ActiveModifiers(), s.ActiveSides(); mods != tt.wantMods || sides != tt.wantSd {
+			t.Errorf("%s: active %v, sides %v; want %v, %v", tt.name, mods, sides, tt.wantMods, tt.wantSd)
+		}
+	}
+}
+
+func TestSenderReleaseAllKeepsLocks(t *testing.T) {
+	s := NewSender()
+	for _, key := range []keytypes.Key{keytypes.KeyCapsLock, keytypes.KeyShiftLeft, keytypes.KeyCtrlRight} {
+		if err := s.KeyDown(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.ReleaseAllModifiers(); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ActiveModifiers(); got != keytypes.ModCapsLock {
+		t.Errorf("ActiveModifiers = %v, want CapsLock", got)
+	}
+	if keys := s.HeldKeys(); len(keys) != 0 {
+		t.Errorf("HeldKeys = %v, want none", keys)
+	}
+}
+
+func TestSenderClosed(t *testing.T) {
+	s := NewSender()
+	s.Close()
+	s.Close()
+	err := s.Tap(keytypes.KeyA)
+	var oe *keytypes.OpError
+	if !errors.As(err, &oe) || oe.Op != "tap" || oe.Key != keytypes.KeyA || !errors.Is(err, keytypes.ErrSenderClosed) {
+		t.Errorf("Tap after Close = %v, want an OpError for A wrapping ErrSenderClosed", err)
+	}
+	if err := s.HoldModifier(keytypes.ModAltGr); !errors.Is(err, keytypes.ErrSenderClosed) {
+		t.Errorf("HoldModifier after Close = %v, want ErrSenderClosed", err)
+	}
+	if s.IsHeld(keytypes.KeyA) {
+		t.Error("A held after a failed Tap")
+	}
+}
+
+func TestSenderFailWith(t *testing.T) {
+	s := NewSender()
+	fail := &keytypes.OpError{Op: "type text", Err: keytypes.ErrNoMapping}
+	s.FailWith(OpTypeText, fail)
+	if err := s.TypeText("nope"); err != fail {
+		t.Errorf("TypeText = %v, want %v", err, fail)
+	}
+	s.FailWith(OpTypeText, nil)
+	if err := s.TypeText("hé"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.TypeCharacter('!'); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Text(); got != "hé!" {
+		t.Errorf("Text = %q, want %q", got, "hé!")
+	}
+	s.Reset()
+	if calls := s.Calls(); len(calls) != 0 {
+		t.Errorf("Calls after Reset = %v, want none", calls)
+	}
+}
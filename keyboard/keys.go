@@ -1,40 +1,75 @@
 package keyboard
 
-/*
-#include <axidev-io/c_api.h>
-#include <stdlib.h>
-*/
-import "C"
-
-import "unsafe"
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
 
 // Key represents a logical keyboard key identifier.
-type Key uint16
-
-// KeyToString converts a Key to its canonical string name.
-func KeyToString(key Key) string {
-	cStr := C.axidev_io_keyboard_key_to_string(C.axidev_io_keyboard_key_t(key))
-	if cStr == nil {
-		return ""
-	}
-	defer C.axidev_io_free_string(cStr)
-	return C.GoString(cStr)
-}
-
-// StringToKey parses a key name string to a Key value.
-// Returns 0 (Key unknown) for unrecognized inputs.
-// The parsing is case-insensitive and accepts common aliases like "esc", "space".
 //
-// Common key names:
-//   - Letters: "A"-"Z"
-//   - Digits: "0"-"9"
-//   - Function keys: "F1"-"F24"
-//   - Navigation: "Up", "Down", "Left", "Right", "Home", "End", "PageUp", "PageDown"
-//   - Editing: "Backspace", "Delete", "Insert", "Tab", "Return", "Enter", "Space"
-//   - Modifiers: "Shift", "Control", "Alt", "Super", "Meta", "CapsLock", "NumLock"
-//   - Special: "Escape", "Esc", "PrintScreen", "ScrollLock", "Pause"
-func StringToKey(name string) Key {
-	cName := C.CString(name)
-	defer C.free(unsafe.Pointer(cName))
-	return Key(C.axidev_io_keyboard_string_to_key(cName))
-}
+// The named keys are available as constants such as KeyA, KeyEnter, KeyF13
+// and KeyNumpad5, generated from the native key table and named after its
+// canonical names; see AllKeys. Key is defined
+// in the cgo-free keytypes package, so code that only handles keys, such as
+// configuration tools, can use it without linking the native library.
+type Key = keytypes.Key
+
+//go:generate go run ./internal/genkeys -o keytypes/keys_gen.go -aliases keys_gen.go
+
+// KeyCategory groups keys by their role on a keyboard.
+type KeyCategory = keytypes.KeyCategory
+
+// Key categories returned by Key.Category.
+const (
+	CategoryUnknown    = keytypes.CategoryUnknown
+	CategoryLetter     = keytypes.CategoryLetter
+	CategoryDigit      = keytypes.CategoryDigit
+	CategoryFunction   = keytypes.CategoryFunction
+	CategoryNavigation = keytypes.CategoryNavigation
+	CategoryEditing    = keytypes.CategoryEditing
+	CategoryNumpad     = keytypes.CategoryNumpad
+	CategoryMedia      = keytypes.CategoryMedia
+	CategoryModifier   = keytypes.CategoryModifier
+	CategoryLock       = keytypes.CategoryLock
+	CategorySymbol     = keytypes.CategorySymbol
+	CategoryControl    = keytypes.CategoryControl
+	CategorySystem     = keytypes.CategorySystem
+)
+
+// KeyToString converts a Key to its canonical string name, or "Unknown" for
+// KeyUnknown and ids that are not in the key table.
+func KeyToString(key Key) string { return keytypes.KeyToString(key) }
+
+// StringToKey parses a key name string to a Key value, case-insensitively
+// and accepting the native library's aliases such as "esc". It returns
+// KeyUnknown for unrecognized inputs. Known names are answered without a
+// cgo call or allocation.
+func StringToKey(name string) Key { return keytypes.StringToKey(name) }
+
+// AllKeys returns every key in the native key table, in id order, excluding
+// KeyUnknown.
+func AllKeys() []Key { return keytypes.AllKeys() }
+
+// KeyFromEvdevCode returns the key for a Linux KEY_* input event code, or
+// KeyUnknown and false if the code has no Key.
+func KeyFromEvdevCode(code uint16) (Key, bool) { return keytypes.KeyFromEvdevCode(code) }
+
+// KeyFromWindowsVK returns the key for a Windows virtual-key code, or
+// KeyUnknown and false if the code has no Key. The side-less VK_SHIFT,
+// VK_CONTROL and VK_MENU map to the left-hand keys.
+func KeyFromWindowsVK(vk uint16) (Key, bool) { return keytypes.KeyFromWindowsVK(vk) }
+
+// KeyFromMacKeyCode returns the key for a macOS virtual keycode, or
+// KeyUnknown and false if the code has no Key.
+func KeyFromMacKeyCode(code uint16) (Key, bool) { return keytypes.KeyFromMacKeyCode(code) }
+
+// HIDUsage identifies a key as a USB HID usage: a usage page and a usage ID
+// within it.
+type HIDUsage = keytypes.HIDUsage
+
+// HID usage pages covered by Key.HIDUsage.
+const (
+	HIDPageKeyboard = keytypes.HIDPageKeyboard
+	HIDPageConsumer = keytypes.HIDPageConsumer
+)
+
+// KeyFromHIDUsage returns the key for a USB HID usage on the Keyboard/Keypad
+// or Consumer page, or KeyUnknown and false if the usage has no Key.
+func KeyFromHIDUsage(u HIDUsage) (Key, bool) { return keytypes.KeyFromHIDUsage(u) }
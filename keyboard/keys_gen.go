@@ -0,0 +1,258 @@
+// Code generated by genkeys from the native key table. DO NOT EDIT.
+
+package keyboard
+
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
+
+// Key constants matching the native key table, from keytypes. Each
+// constant's canonical name, as returned by KeyToString, is noted beside it.
+const (
+	KeyUnknown            = keytypes.KeyUnknown
+	KeyA                  = keytypes.KeyA                  // "A"
+	KeyB                  = keytypes.KeyB                  // "B"
+	KeyC                  = keytypes.KeyC                  // "C"
+	KeyD                  = keytypes.KeyD                  // "D"
+	KeyE                  = keytypes.KeyE                  // "E"
+	KeyF                  = keytypes.KeyF                  // "F"
+	KeyG                  = keytypes.KeyG                  // "G"
+	KeyH                  = keytypes.KeyH                  // "H"
+	KeyI                  = keytypes.KeyI                  // "I"
+	KeyJ                  = keytypes.KeyJ                  // "J"
+	KeyK                  = keytypes.KeyK                  // "K"
+	KeyL                  = keytypes.KeyL                  // "L"
+	KeyM                  = keytypes.KeyM                  // "M"
+	KeyN                  = keytypes.KeyN                  // "N"
+	KeyO                  = keytypes.KeyO                  // "O"
+	KeyP                  = keytypes.KeyP                  // "P"
+	KeyQ                  = keytypes.KeyQ                  // "Q"
+	KeyR                  = keytypes.KeyR                  // "R"
+	KeyS                  = keytypes.KeyS                  // "S"
+	KeyT                  = keytypes.KeyT                  // "T"
+	KeyU                  = keytypes.KeyU                  // "U"
+	KeyV                  = keytypes.KeyV                  // "V"
+	KeyW                  = keytypes.KeyW                  // "W"
+	KeyX                  = keytypes.KeyX                  // "X"
+	KeyY                  = keytypes.KeyY                  // "Y"
+	KeyZ                  = keytypes.KeyZ                  // "Z"
+	Key0                  = keytypes.Key0                  // "0"
+	Key1                  = keytypes.Key1                  // "1"
+	Key2                  = keytypes.Key2                  // "2"
+	Key3                  = keytypes.Key3                  // "3"
+	Key4                  = keytypes.Key4                  // "4"
+	Key5                  = keytypes.Key5                  // "5"
+	Key6                  = keytypes.Key6                  // "6"
+	Key7                  = keytypes.Key7                  // "7"
+	Key8                  = keytypes.Key8                  // "8"
+	Key9                  = keytypes.Key9                  // "9"
+	KeyF1                 = keytypes.KeyF1                 // "F1"
+	KeyF2                 = keytypes.KeyF2                 // "F2"
+	KeyF3                 = keytypes.KeyF3                 // "F3"
+	KeyF4                 = keytypes.KeyF4                 // "F4"
+	KeyF5                 = keytypes.KeyF5                 // "F5"
+	KeyF6                 = keytypes.KeyF6                 // "F6"
+	KeyF7                 = keytypes.KeyF7                 // "F7"
+	KeyF8                 = keytypes.KeyF8                 // "F8"
+	KeyF9                 = keytypes.KeyF9                 // "F9"
+	KeyF10                = keytypes.KeyF10                // "F10"
+	KeyF11                = keytypes.KeyF11                // "F11"
+	KeyF12                = keytypes.KeyF12                // "F12"
+	KeyF13                = keytypes.KeyF13                // "F13"
+	KeyF14                = keytypes.KeyF14                // "F14"
+	KeyF15                = keytypes.KeyF15                // "F15"
+	KeyF16                = keytypes.KeyF16                // "F16"
+	KeyF17                = keytypes.KeyF17                // "F17"
+	KeyF18                = keytypes.KeyF18                // "F18"
+	KeyF19                = keytypes.KeyF19                // "F19"
+	KeyF20                = keytypes.KeyF20                // "F20"
+	KeyEnter              = keytypes.KeyEnter              // "Enter"
+	KeyEscape             = keytypes.KeyEscape             // "Escape"
+	KeyBackspace          = keytypes.KeyBackspace          // "Backspace"
+	KeyTab                = keytypes.KeyTab                // "Tab"
+	KeySpace              = keytypes.KeySpace              // "Space"
+	KeyLeft               = keytypes.KeyLeft               // "Left"
+	KeyRight              = keytypes.KeyRight              // "Right"
+	KeyUp                 = keytypes.KeyUp                 // "Up"
+	KeyDown               = keytypes.KeyDown               // "Down"
+	KeyHome               = keytypes.KeyHome               // "Home"
+	KeyEnd                = keytypes.KeyEnd                // "End"
+	KeyPageUp             = keytypes.KeyPageUp             // "PageUp"
+	KeyPageDown           = keytypes.KeyPageDown           // "PageDown"
+	KeyDelete             = keytypes.KeyDelete             // "Delete"
+	KeyInsert             = keytypes.KeyInsert             // "Insert"
+	KeyPrintScreen        = keytypes.KeyPrintScreen        // "PrintScreen"
+	KeyScrollLock         = keytypes.KeyScrollLock         // "ScrollLock"
+	KeyPause              = keytypes.KeyPause              // "Pause"
+	KeyNumpadDivide       = keytypes.KeyNumpadDivide       // "NumpadDivide"
+	KeyNumpadMultiply     = keytypes.KeyNumpadMultiply     // "NumpadMultiply"
+	KeyNumpadMinus        = keytypes.KeyNumpadMinus        // "NumpadMinus"
+	KeyNumpadPlus         = keytypes.KeyNumpadPlus         // "NumpadPlus"
+	KeyNumpadEnter        = keytypes.KeyNumpadEnter        // "NumpadEnter"
+	KeyNumpadDecimal      = keytypes.KeyNumpadDecimal      // "NumpadDecimal"
+	KeyNumpad0            = keytypes.KeyNumpad0            // "Numpad0"
+	KeyNumpad1            = keytypes.KeyNumpad1            // "Numpad1"
+	KeyNumpad2            = keytypes.KeyNumpad2            // "Numpad2"
+	KeyNumpad3            = keytypes.KeyNumpad3            // "Numpad3"
+	KeyNumpad4            = keytypes.KeyNumpad4            // "Numpad4"
+	KeyNumpad5            = keytypes.KeyNumpad5            // "Numpad5"
+	KeyNumpad6            = keytypes.KeyNumpad6            // "Numpad6"
+	KeyNumpad7            = keytypes.KeyNumpad7            // "Numpad7"
+	KeyNumpad8            = keytypes.KeyNumpad8            // "Numpad8"
+	KeyNumpad9            = keytypes.KeyNumpad9            // "Numpad9"
+	KeyShiftLeft          = keytypes.KeyShiftLeft          // "ShiftLeft"
+	KeyShiftRight         = keytypes.KeyShiftRight         // "ShiftRight"
+	KeyCtrlLeft           = keytypes.KeyCtrlLeft           // "CtrlLeft"
+	KeyCtrlRight          = keytypes.KeyCtrlRight          // "CtrlRight"
+	KeyAltLeft            = keytypes.KeyAltLeft            // "AltLeft"
+	KeyAltRight           = keytypes.KeyAltRight           // "AltRight"
+	KeySuperLeft          = keytypes.KeySuperLeft          // "SuperLeft"
+	KeySuperRight         = keytypes.KeySuperRight         // "SuperRight"
+	KeyCapsLock           = keytypes.KeyCapsLock           // "CapsLock"
+	KeyNumLock            = keytypes.KeyNumLock            // "NumLock"
+	KeyHelp               = keytypes.KeyHelp               // "Help"
+	KeyMenu               = keytypes.KeyMenu               // "Menu"
+	KeyPower              = keytypes.KeyPower              // "Power"
+	KeySleep              = keytypes.KeySleep              // "Sleep"
+	KeyWake               = keytypes.KeyWake               // "Wake"
+	KeyMute               = keytypes.KeyMute               // "Mute"
+	KeyVolumeDown         = keytypes.KeyVolumeDown         // "VolumeDown"
+	KeyVolumeUp           = keytypes.KeyVolumeUp           // "VolumeUp"
+	KeyMediaPlayPause     = keytypes.KeyMediaPlayPause     // "MediaPlayPause"
+	KeyMediaStop          = keytypes.KeyMediaStop          // "MediaStop"
+	KeyMediaNext          = keytypes.KeyMediaNext          // "MediaNext"
+	KeyMediaPrevious      = keytypes.KeyMediaPrevious      // "MediaPrevious"
+	KeyBrightnessDown     = keytypes.KeyBrightnessDown     // "BrightnessDown"
+	KeyBrightnessUp       = keytypes.KeyBrightnessUp       // "BrightnessUp"
+	KeyEject              = keytypes.KeyEject              // "Eject"
+	KeyGrave              = keytypes.KeyGrave              // "`"
+	KeyMinus              = keytypes.KeyMinus              // "-"
+	KeyEqual              = keytypes.KeyEqual              // "="
+	KeyLeftBracket        = keytypes.KeyLeftBracket        // "["
+	KeyRightBracket       = keytypes.KeyRightBracket       // "]"
+	KeyBackslash          = keytypes.KeyBackslash          // "\\"
+	KeySemicolon          = keytypes.KeySemicolon          // ";"
+	KeyApostrophe         = keytypes.KeyApostrophe         // "'"
+	KeyComma              = keytypes.KeyComma              // ","
+	KeyPeriod             = keytypes.KeyPeriod             // "."
+	KeySlash              = keytypes.KeySlash              // "/"
+	KeyAt                 = keytypes.KeyAt                 // "At"
+	KeyHashtag            = keytypes.KeyHashtag            // "Hashtag"
+	KeyExclamation        = keytypes.KeyExclamation        // "Exclamation"
+	KeyDollar             = keytypes.KeyDollar             // "Dollar"
+	KeyPercent            = keytypes.KeyPercent            // "Percent"
+	KeyCaret              = keytypes.KeyCaret              // "Caret"
+	KeyAmpersand          = keytypes.KeyAmpersand          // "Ampersand"
+	KeyAsterisk           = keytypes.KeyAsterisk           // "Asterisk"
+	KeyLeftParen          = keytypes.KeyLeftParen          // "LeftParen"
+	KeyRightParen         = keytypes.KeyRightParen         // "RightParen"
+	KeyUnderscore         = keytypes.KeyUnderscore         // "Underscore"
+	KeyPlus               = keytypes.KeyPlus               // "Plus"
+	KeyColon              = keytypes.KeyColon              // "Colon"
+	KeyQuote              = keytypes.KeyQuote              // "Quote"
+	KeyQuestionMark       = keytypes.KeyQuestionMark       // "QuestionMark"
+	KeyBar                = keytypes.KeyBar                // "Bar"
+	KeyLessThan           = keytypes.KeyLessThan           // "LessThan"
+	KeyGreaterThan        = keytypes.KeyGreaterThan        // "GreaterThan"
+	KeyNUL                = keytypes.KeyNUL                // "NUL"
+	KeySOH                = keytypes.KeySOH                // "SOH"
+	KeySTX                = keytypes.KeySTX                // "STX"
+	KeyETX                = keytypes.KeyETX                // "ETX"
+	KeyEOT                = keytypes.KeyEOT                // "EOT"
+	KeyENQ                = keytypes.KeyENQ                // "ENQ"
+	KeyACK                = keytypes.KeyACK                // "ACK"
+	KeyBell               = keytypes.KeyBell               // "Bell"
+	KeyVT                 = keytypes.KeyVT                 // "VT"
+	KeyFF                 = keytypes.KeyFF                 // "FF"
+	KeySO                 = keytypes.KeySO                 // "SO"
+	KeySI                 = keytypes.KeySI                 // "SI"
+	KeyDLE                = keytypes.KeyDLE                // "DLE"
+	KeyDC1                = keytypes.KeyDC1                // "DC1"
+	KeyDC2                = keytypes.KeyDC2                // "DC2"
+	KeyDC3                = keytypes.KeyDC3                // "DC3"
+	KeyDC4                = keytypes.KeyDC4                // "DC4"
+	KeyNAK                = keytypes.KeyNAK                // "NAK"
+	KeySYN                = keytypes.KeySYN                // "SYN"
+	KeyETB                = keytypes.KeyETB                // "ETB"
+	KeyCAN                = keytypes.KeyCAN                // "CAN"
+	KeyEM                 = keytypes.KeyEM                 // "EM"
+	KeySUB                = keytypes.KeySUB                // "SUB"
+	KeyFS                 = keytypes.KeyFS                 // "FS"
+	KeyGS                 = keytypes.KeyGS                 // "GS"
+	KeyRS                 = keytypes.KeyRS                 // "RS"
+	KeyUS                 = keytypes.KeyUS                 // "US"
+	KeyNumpadEqual        = keytypes.KeyNumpadEqual        // "NumpadEqual"
+	KeyDegree             = keytypes.KeyDegree             // "Degree"
+	KeySterling           = keytypes.KeySterling           // "Sterling"
+	KeyMu                 = keytypes.KeyMu                 // "Mu"
+	KeyPlusMinus          = keytypes.KeyPlusMinus          // "PlusMinus"
+	KeyDeadCircumflex     = keytypes.KeyDeadCircumflex     // "DeadCircumflex"
+	KeyDeadDiaeresis      = keytypes.KeyDeadDiaeresis      // "DeadDiaeresis"
+	KeySection            = keytypes.KeySection            // "Section"
+	KeyCancel             = keytypes.KeyCancel             // "Cancel"
+	KeyRedo               = keytypes.KeyRedo               // "Redo"
+	KeyUndo               = keytypes.KeyUndo               // "Undo"
+	KeyFind               = keytypes.KeyFind               // "Find"
+	KeyHangul             = keytypes.KeyHangul             // "Hangul"
+	KeyHangulHanja        = keytypes.KeyHangulHanja        // "HangulHanja"
+	KeyKatakana           = keytypes.KeyKatakana           // "Katakana"
+	KeyHiragana           = keytypes.KeyHiragana           // "Hiragana"
+	KeyHenkan             = keytypes.KeyHenkan             // "Henkan"
+	KeyMuhenkan           = keytypes.KeyMuhenkan           // "Muhenkan"
+	KeyOE                 = keytypes.KeyOE                 // "OE"
+	KeyOESmall            = keytypes.KeyOESmall            // "oe"
+	KeySunProps           = keytypes.KeySunProps           // "SunProps"
+	KeySunFront           = keytypes.KeySunFront           // "SunFront"
+	KeyCopy               = keytypes.KeyCopy               // "Copy"
+	KeyOpen               = keytypes.KeyOpen               // "Open"
+	KeyPaste              = keytypes.KeyPaste              // "Paste"
+	KeyCut                = keytypes.KeyCut                // "Cut"
+	KeyCalculator         = keytypes.KeyCalculator         // "Calculator"
+	KeyExplorer           = keytypes.KeyExplorer           // "Explorer"
+	KeyPhone              = keytypes.KeyPhone              // "Phone"
+	KeyWebCam             = keytypes.KeyWebCam             // "WebCam"
+	KeyAudioRecord        = keytypes.KeyAudioRecord        // "AudioRecord"
+	KeyAudioRewind        = keytypes.KeyAudioRewind        // "AudioRewind"
+	KeyAudioPreset        = keytypes.KeyAudioPreset        // "AudioPreset"
+	KeyMessenger          = keytypes.KeyMessenger          // "Messenger"
+	KeySearch             = keytypes.KeySearch             // "Search"
+	KeyGo                 = keytypes.KeyGo                 // "Go"
+	KeyFinance            = keytypes.KeyFinance            // "Finance"
+	KeyGame               = keytypes.KeyGame               // "Game"
+	KeyShop               = keytypes.KeyShop               // "Shop"
+	KeyHomePage           = keytypes.KeyHomePage           // "HomePage"
+	KeyReload             = keytypes.KeyReload             // "Reload"
+	KeyClose              = keytypes.KeyClose              // "Close"
+	KeySend               = keytypes.KeySend               // "Send"
+	KeyXfer               = keytypes.KeyXfer               // "Xfer"
+	KeyLaunchA            = keytypes.KeyLaunchA            // "LaunchA"
+	KeyLaunchB            = keytypes.KeyLaunchB            // "LaunchB"
+	KeyLaunch1            = keytypes.KeyLaunch1            // "Launch1"
+	KeyLaunch2            = keytypes.KeyLaunch2            // "Launch2"
+	KeyLaunch3            = keytypes.KeyLaunch3            // "Launch3"
+	KeyLaunch4            = keytypes.KeyLaunch4            // "Launch4"
+	KeyLaunch5            = keytypes.KeyLaunch5            // "Launch5"
+	KeyLaunch6            = keytypes.KeyLaunch6            // "Launch6"
+	KeyLaunch7            = keytypes.KeyLaunch7            // "Launch7"
+	KeyLaunch8            = keytypes.KeyLaunch8            // "Launch8"
+	KeyLaunch9            = keytypes.KeyLaunch9            // "Launch9"
+	KeyTouchpadToggle     = keytypes.KeyTouchpadToggle     // "TouchpadToggle"
+	KeyTouchpadOn         = keytypes.KeyTouchpadOn         // "TouchpadOn"
+	KeyTouchpadOff        = keytypes.KeyTouchpadOff        // "TouchpadOff"
+	KeyKbdLightOnOff      = keytypes.KeyKbdLightOnOff      // "KbdLightOnOff"
+	KeyKbdBrightnessDown  = keytypes.KeyKbdBrightnessDown  // "KbdBrightnessDown"
+	KeyKbdBrightnessUp    = keytypes.KeyKbdBrightnessUp    // "KbdBrightnessUp"
+	KeyMail               = keytypes.KeyMail               // "Mail"
+	KeyMailForward        = keytypes.KeyMailForward        // "MailForward"
+	KeySave               = keytypes.KeySave               // "Save"
+	KeyDocuments          = keytypes.KeyDocuments          // "Documents"
+	KeyBattery            = keytypes.KeyBattery            // "Battery"
+	KeyBluetooth          = keytypes.KeyBluetooth          // "Bluetooth"
+	KeyWLAN               = keytypes.KeyWLAN               // "WLAN"
+	KeyUWB                = keytypes.KeyUWB                // "UWB"
+	KeyNextVMode          = keytypes.KeyNextVMode          // "Next_VMode"
+	KeyPrevVMode          = keytypes.KeyPrevVMode          // "Prev_VMode"
+	KeyMonBrightnessCycle = keytypes.KeyMonBrightnessCycle // "MonBrightnessCycle"
+	KeyBrightnessAuto     = keytypes.KeyBrightnessAuto     // "BrightnessAuto"
+	KeyDisplayOff         = keytypes.KeyDisplayOff         // "DisplayOff"
+	KeyWWAN               = keytypes.KeyWWAN               // "WWAN"
+	KeyRFKill             = keytypes.KeyRFKill             // "RFKill"
+)
@@ -0,0 +1,33 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeyNamesMatchNative(t *testing.T) {
+	for _, k := range AllKeys() {
+		name := KeyToString(k)
+		if got := nativeStringToKey(name); got != k {
+			t.Errorf("native parse of %q = %v, want %v", name, got, k)
+		}
+	}
+}
+
+func TestStringToKeyMatchesNative(t *testing.T) {
+	names := []string{
+		"esc", "Return", "CTRL", "kp_5", "kp5", "num5", "spacebar", "prior",
+		"dead_circumflex", "iso_level3_shift", "+", "\"", "No Such Key",
+		"enterr", strings.Repeat("a", 100),
+	}
+	for _, name := range names {
+		// Probe the parser with a spelling that is not canonical, as
+		// StringToKey answers case-insensitively.
+		want := nativeStringToKey(strings.ToUpper(name))
+		if got := StringToKey(name); got != want {
+			t.Errorf("StringToKey(%q) = %v, native parser gives %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package keytypes
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+)
+
+// Chord is a key combination: a set of modifiers held while a key is tapped,
+// such as Ctrl+Shift+S.
+type Chord struct {
+	Mods Modifier
+	Key  Key
+}
+
+// ChordStyle selects how Chord.Format renders a chord.
+type ChordStyle uint8
+
+const (
+	// ChordStylePlatform renders chords in the style of the platform the
+	// program runs on.
+	ChordStylePlatform ChordStyle = iota
+
+	// ChordStyleLinux renders "Ctrl+Alt+Shift+Super+S".
+	ChordStyleLinux
+
+	// ChordStyleWindows renders "Ctrl+Alt+Shift+Win+S".
+	ChordStyleWindows
+
+	// ChordStyleMac renders macOS menu symbols in Apple's order: "⌃⌥⇧⌘S".
+	ChordStyleMac
+)
+
+// chordMods lists the modifiers a chord can hold, in the order they are
+// written, with their generic, left and right names in the Linux and
+// Windows styles and their macOS symbol, which does not distinguish sides.
+// Extended modifiers have no macOS symbol and are written by name.
+var chordMods = []struct {
+	mod, left, right Modifier
+	linux, windows   [3]string
+	mac              string
+}{
+	{ModCtrl, ModLeftCtrl, ModRightCtrl, [3]string{"Ctrl", "LeftCtrl", "RightCtrl"}, [3]string{"Ctrl", "LeftCtrl", "RightCtrl"}, "⌃"},
+	{ModAlt, ModLeftAlt, ModRightAlt, [3]string{"Alt", "LeftAlt", "RightAlt"}, [3]string{"Alt", "LeftAlt", "RightAlt"}, "⌥"},
+	{ModShift, ModLeftShift, ModRightShift, [3]string{"Shift", "LeftShift", "RightShift"}, [3]string{"Shift", "LeftShift", "RightShift"}, "⇧"},
+	{ModSuper, ModLeftSuper, ModRightSuper, [3]string{"Super", "LeftSuper", "RightSuper"}, [3]string{"Win", "LeftWin", "RightWin"}, "⌘"},
+	{ModAltGr, 0, 0, [3]string{"AltGr"}, [3]string{"AltGr"}, ""},
+	{ModMeta, 0, 0, [3]string{"Meta"}, [3]string{"Meta"}, ""},
+	{ModHyper, 0, 0, [3]string{"Hyper"}, [3]string{"Hyper"}, ""},
+}
+
+// modifierNames maps the lower-case names accepted by ParseChord to the
+// modifier they select.
+var modifierNames = map[string]Modifier{
+	"ctrl":    ModCtrl,
+	"control": ModCtrl,
+	"ctl":     ModCtrl,
+	"⌃":       ModCtrl,
+	"alt":     ModAlt,
+	"option":  ModAlt,
+	"opt":     ModAlt,
+	"⌥":       ModAlt,
+	"shift":   ModShift,
+	"⇧":       ModShift,
+	"super":   ModSuper,
+	"win":     ModSuper,
+	"windows": ModSuper,
+	"cmd":     ModSuper,
+	"command": ModSuper,
+	"⌘":       ModSuper,
+
+	"leftctrl":    ModLeftCtrl,
+	"lctrl":       ModLeftCtrl,
+	"rightctrl":   ModRightCtrl,
+	"rctrl":       ModRightCtrl,
+	"leftalt":     ModLeftAlt,
+	"lalt":        ModLeftAlt,
+	"rightalt":    ModRightAlt,
+	"ralt":        ModRightAlt,
+	"leftoption":  ModLeftAlt,
+	"rightoption": ModRightAlt,
+	"leftshift":   ModLeftShift,
+	"lshift":      ModLeftShift,
+	"rightshift":  ModRightShift,
+	"rshift":      ModRightShift,
+	"leftsuper":   ModLeftSuper,
+	"rightsuper":  ModRightSuper,
+	"leftwin":     ModLeftSuper,
+	"lwin":        ModLeftSuper,
+	"rightwin":    ModRightSuper,
+	"rwin":        ModRightSuper,
+	"leftcmd":     ModLeftSuper,
+	"rightcmd":    ModRightSuper,
+
+	"altgr":            ModAltGr,
+	"level3":           ModAltGr,
+	"iso_level3_shift": ModAltGr,
+	"meta":             ModMeta,
+	"hyper":            ModHyper,
+}
+
+// macKeySymbols are the symbols macOS menus use for keys with no printable
+// name.
+var macKeySymbols = map[Key]string{
+	KeyEnter:     "↩",
+	KeyEscape:    "⎋",
+	KeyTab:       "⇥",
+	KeyBackspace: "⌫",
+	KeyDelete:    "⌦",
+	KeyLeft:      "←",
+	KeyRight:     "→",
+	KeyUp:        "↑",
+	KeyDown:      "↓",
+	KeyHome:      "↖",
+	KeyEnd:       "↘",
+	KeyPageUp:    "⇞",
+	KeyPageDown:  "⇟",
+}
+
+// ParseChord parses an accelerator string such as "Ctrl+Shift+S",
+// "alt+F4" or "⌃⇧S".
+//
+// Parts are separated by '+'. Every part but the last must name a modifier
+// (Ctrl/Control, Alt/Option, Shift, Super/Win/Cmd, AltGr, Meta, Hyper, a
+// side-specific form such as RightCtrl or LShift, or a macOS modifier
+// symbol, which needs no separator); the last part names the key as
+// accepted by StringToKey.
+// Side-specific modifiers set their generic bit too, so the Mods of
+// "RightAlt+E" are ModAlt|ModRightAlt. Names are case-insensitive. A '+'
+// where a part is expected names the '+' key itself, so "Ctrl++" is
+// Ctrl+Plus (KeyPlus), not the '=' key that types '+' with Shift on a US
+// layout.
+//
+// "Meta" is the extended modifier ModMeta, which matches nothing until a
+// key is assigned to it with SetModifierKeys; the Super, Windows or Command
+// key is "Super", "Win" or "Cmd".
+//
+// Errors are *ChordParseError values wrapping ErrInvalidChord.
+func ParseChord(s string) (Chord, error) {
+	var c Chord
+	if strings.TrimSpace(s) == "" {
+		return c, &ChordParseError{Input: s, Msg: "empty chord"}
+	}
+	i := 0
+	for {
+		start := i
+		var part string
+		if r, size := utf8.DecodeRuneInString(s[i:]); isModifierSymbol(r) {
+			part = s[i : i+size]
+			i += size
+		} else if s[i] == '+' {
+			part = "+"
+			i++
+		} else {
+			end := strings.IndexAny(s[i:], "+⌃⌥⇧⌘")
+			if end < 0 {
+				end = len(s) - i
+			}
+			part = s[i : i+end]
+			i += end
+		}
+		name := strings.TrimSpace(part)
+		last := i == len(s)
+
+		if mod, ok := modifierNames[strings.ToLower(name)]; ok && !last {
+			if c.Mods&mod != 0 {
+				return Chord{}, &ChordParseError{Input: s, Offset: start, Part: part, Msg: "duplicate modifier"}
+			}
+			c.Mods |= mod
+			if s[i] == '+' && (!isModifierSymbol(firstRune(part)) || i+1 < len(s)) {
+				i++ // skip the separator
+			}
+			if i == len(s) {
+				return Chord{}, &ChordParseError{Input: s, Offset: i, Msg: "missing key after modifiers"}
+			}
+			continue
+		}
+
+		if !last {
+			return Chord{}, &ChordParseError{Input: s, Offset: start, Part: part, Msg: "not a modifier"}
+		}
+		if name == "" {
+			return Chord{}, &ChordParseError{Input: s, Offset: start, Part: part, Msg: "empty key name"}
+		}
+		if _, ok := modifierNames[strings.ToLower(name)]; ok {
+			return Chord{}, &ChordParseError{Input: s, Offset: start, Part: part, Msg: "expected a key, found modifier"}
+		}
+		if c.Key = lookupChordKey(name); c.Key == KeyUnknown {
+			return Chord{}, &ChordParseError{Input: s, Offset: start, Part: part, Msg: "unknown key"}
+		}
+		c.Mods = c.Mods.Normalize()
+		return c, nil
+	}
+}
+
+// MustParseChord is like ParseChord but panics if s cannot be parsed. It is
+// intended for chords written as constants in source code.
+func MustParseChord(s string) Chord {
+	c, err := ParseChord(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// lookupChordKey resolves a key name, accepting "+" for KeyPlus and the
+// macOS key symbols that Format produces.
+func lookupChordKey(name string) Key {
+	if name == "+" {
+		return KeyPlus
+	}
+	for k, sym := range macKeySymbols {
+		if name == sym {
+			return k
+		}
+	}
+	return StringToKey(name)
+}
+
+func isModifierSymbol(r rune) bool {
+	switch r {
+	case '⌃', '⌥', '⇧', '⌘':
+		return true
+	}
+	return false
+}
+
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+// String returns the chord in ChordStyleLinux, which ParseChord accepts.
+func (c Chord) String() string {
+	return c.Format(ChordStyleLinux)
+}
+
+// Format renders the chord in the given style. Modifiers are written in a
+// fixed order regardless of how the chord was parsed, with side-specific
+// names (RightCtrl) where a side-specific bit is set. ChordStyleMac has no
+// side-specific symbols and writes both sides as the generic one.
+func (c Chord) Format(style ChordStyle) string {
+	if style == ChordStylePlatform {
+		style = platformChordStyle()
+	}
+	var b strings.Builder
+	mods := c.Mods.Normalize()
+	for _, m := range chordMods {
+		if mods&m.mod == 0 {
+			continue
+		}
+		if style == ChordStyleMac && m.mac != "" {
+			b.WriteString(m.mac)
+			continue
+		}
+		names := m.linux
+		if style == ChordStyleWindows {
+			names = m.windows
+		}
+		if mods&(m.left|m.right) == 0 {
+			b.WriteString(names[0])
+			b.WriteByte('+')
+		}
+		if mods&m.left != 0 {
+			b.WriteString(names[1])
+			b.WriteByte('+')
+		}
+		if mods&m.right != 0 {
+			b.WriteString(names[2])
+			b.WriteByte('+')
+		}
+	}
+	if sym, ok := macKeySymbols[c.Key]; ok && style == ChordStyleMac {
+		b.WriteString(sym)
+	} else {
+		b.WriteString(KeyToString(c.Key))
+	}
+	return b.String()
+}
+
+func platformChordStyle() ChordStyle {
+	switch runtime.GOOS {
+	case "darwin":
+		return ChordStyleMac
+	case "windows":
+		return ChordStyleWindows
+	}
+	return ChordStyleLinux
+}
+
+// ChordParseError reports why ParseChord rejected its input.
+type ChordParseError struct {
+	// Input is the string passed to ParseChord.
+	Input string
+
+	// Offset is the byte offset in Input of the offending part.
+	Offset int
+
+	// Part is the offending part, if any.
+	Part string
+
+	// Msg describes the problem, such as "unknown key".
+	Msg string
+}
+
+// Error returns a description of the problem and where it occurred.
+func (e *ChordParseError) Error() string {
+	if e.Part != "" {
+		return fmt.Sprintf("parse chord %q: %s %q at offset %d", e.Input, e.Msg, e.Part, e.Offset)
+	}
+	return fmt.Sprintf("parse chord %q: %s at offset %d", e.Input, e.Msg, e.Offset)
+}
+
+// Unwrap returns ErrInvalidChord.
+func (e *ChordParseError) Unwrap() error { return ErrInvalidChord }
@@ -0,0 +1,126 @@
+package keytypes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseChord(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Chord
+	}{
+		{"Ctrl+S", Chord{ModCtrl, KeyS}},
+		{"ctrl+s", Chord{ModCtrl, KeyS}},
+		{"Control+S", Chord{ModCtrl, KeyS}},
+		{"CTL + s", Chord{ModCtrl, KeyS}},
+		{"alt+F4", Chord{ModAlt, KeyF4}},
+		{"Option+Cmd+Esc", Chord{ModAlt | ModSuper, KeyEscape}},
+		{"Shift+Win+Return", Chord{ModShift | ModSuper, KeyEnter}},
+		{"RightAlt+E", Chord{ModAlt | ModRightAlt, KeyE}},
+		{"lshift+rctrl+Tab", Chord{ModShift | ModLeftShift | ModCtrl | ModRightCtrl, KeyTab}},
+		{"LeftCtrl+RightCtrl+C", Chord{ModCtrl | ModLeftCtrl | ModRightCtrl, KeyC}},
+		{"AltGr+E", Chord{ModAltGr, KeyE}},
+		{"Meta+X", Chord{ModMeta, KeyX}},
+		{"Hyper+K", Chord{ModHyper, KeyK}},
+		{"⌃⇧S", Chord{ModCtrl | ModShift, KeyS}},
+		{"⌘↩", Chord{ModSuper, KeyEnter}},
+		{"Ctrl++", Chord{ModCtrl, KeyPlus}},
+		{"+", Chord{0, KeyPlus}},
+		{"Ctrl+Plus", Chord{ModCtrl, KeyPlus}},
+	}
+	for _, tt := range tests {
+		got, err := ParseChord(tt.in)
+		if err != nil {
+			t.Errorf("ParseChord(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseChord(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseChordErrors(t *testing.T) {
+	tests := []struct {
+		in     string
+		offset int
+		part   string
+		msg    string
+	}{
+		{"", 0, "", "empty chord"},
+		{"  ", 0, "", "empty chord"},
+		{"Ctrl+", 5, "", "missing key after modifiers"},
+		{"Ctrl+S+", 5, "S", "not a modifier"},
+		{"Ctrl+ ", 5, " ", "empty key name"},
+		{"Foo+S", 0, "Foo", "not a modifier"},
+		{"Ctrl+Nope", 5, "Nope", "unknown key"},
+		{"Ctrl+Control+S", 5, "Control", "duplicate modifier"},
+		{"Ctrl+Super", 5, "Super", "expected a key, found modifier"},
+		{"Shift", 0, "Shift", "expected a key, found modifier"},
+	}
+	for _, tt := range tests {
+		_, err := ParseChord(tt.in)
+		var perr *ChordParseError
+		if !errors.As(err, &perr) || !errors.Is(err, ErrInvalidChord) {
+			t.Errorf("ParseChord(%q) = %v, want a ChordParseError", tt.in, err)
+			continue
+		}
+		if perr.Offset != tt.offset || perr.Part != tt.part || perr.Msg != tt.msg {
+			t.Errorf("ParseChord(%q): offset %d, part %q, msg %q; want %d, %q, %q",
+				tt.in, perr.Offset, perr.Part, perr.Msg, tt.offset, tt.part, tt.msg)
+		}
+	}
+}
+
+func TestChordFormatRoundTrip(t *testing.T) {
+	chords := []Chord{
+		{ModCtrl, KeyS},
+		{ModCtrl | ModAlt | ModShift | ModSuper, KeyF4},
+		{ModAlt | ModRightAlt, KeyE},
+		{ModShift | ModLeftShift | ModCtrl | ModRightCtrl, KeyTab},
+		{ModSuper | ModLeftSuper, KeyL},
+		{ModAltGr | ModMeta | ModHyper, KeyK},
+		{ModCtrl, KeyPlus},
+		{ModSuper, KeyEnter},
+	}
+	for _, c := range chords {
+		for _, style := range []ChordStyle{ChordStyleLinux, ChordStyleWindows, ChordStyleMac, ChordStylePlatform} {
+			s := c.Format(style)
+			got, err := ParseChord(s)
+			if err != nil {
+				t.Errorf("ParseChord(%v.Format(%d) = %q): %v", c, style, s, err)
+				continue
+			}
+			want := c
+			if style == ChordStyleMac || (style == ChordStylePlatform && platformChordStyle() == ChordStyleMac) {
+				// There are no side-specific symbols, so only the generic
+				// bits survive.
+				want.Mods = c.Mods.Generic()
+			}
+			if got != want {
+				t.Errorf("ParseChord(%q) = %v, want %v", s, got, want)
+			}
+		}
+	}
+}
+
+func TestChordFormatStyles(t *testing.T) {
+	c := Chord{ModCtrl | ModShift | ModSuper | ModRightSuper, KeyS}
+	tests := []struct {
+		style ChordStyle
+		want  string
+	}{
+		{ChordStyleLinux, "Ctrl+Shift+RightSuper+S"},
+		{ChordStyleWindows, "Ctrl+Shift+RightWin+S"},
+		{ChordStyleMac, "⌃⇧⌘S"},
+	}
+	for _, tt := range tests {
+		if got := c.Format(tt.style); got != tt.want {
+			t.Errorf("Format(%d) = %q, want %q", tt.style, got, tt.want)
+		}
+	}
+	if got := (Chord{ModCtrl | ModAltGr, KeyEnter}).Format(ChordStyleMac); got != "⌃AltGr+↩" {
+		t.Errorf("Format(ChordStyleMac) with AltGr = %q, want %q", got, "⌃AltGr+↩")
+	}
+}
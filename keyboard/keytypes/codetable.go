@@ -0,0 +1,43 @@
+package keytypes
+
+// codePair associates a Key with a platform or protocol key code.
+type codePair[C comparable] struct {
+	key  Key
+	code C
+}
+
+// codeTable is a bidirectional mapping between keys and the codes of one
+// platform or protocol. When several keys share a code, the first listed
+// wins for the code-to-key direction.
+type codeTable[C comparable] struct {
+	codes map[Key]C
+	keys  map[C]Key
+}
+
+func newCodeTable[C comparable](pairs []codePair[C]) *codeTable[C] {
+	t := &codeTable[C]{
+		codes: make(map[Key]C, len(pairs)),
+		keys:  make(map[C]Key, len(pairs)),
+	}
+	for _, p := range pairs {
+		if _, dup := t.codes[p.key]; !dup {
+			t.codes[p.key] = p.code
+		}
+		if _, dup := t.keys[p.code]; !dup {
+			t.keys[p.code] = p.key
+		}
+	}
+	return t
+}
+
+// code returns the code for k.
+func (t *codeTable[C]) code(k Key) (C, bool) {
+	c, ok := t.codes[k]
+	return c, ok
+}
+
+// key returns the key for c, or KeyUnknown.
+func (t *codeTable[C]) key(c C) (Key, bool) {
+	k, ok := t.keys[c]
+	return k, ok
+}
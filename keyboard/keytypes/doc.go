@@ -0,0 +1,14 @@
+// Package keytypes defines the keyboard package's value types without cgo:
+// Key and its generated constants, Modifier, Chord, KeyEvent, the Injector
+// and EventSource interfaces, the sentinel errors, and the tables that map
+// keys to evdev, USB HID, Windows virtual-key and macOS kVK codes.
+//
+// The keyboard package re-exports all of these under the same names, so most
+// code should import keyboard. Import keytypes directly from code that must
+// build without the native library, such as configuration tools running on
+// another OS, and from packages such as keyboardtest and hotkey whose users
+// should be able to test without it:
+//
+//	k, _ := keytypes.KeyFromWindowsVK(0x41)
+//	code, _ := k.EvdevCode() // KEY_A
+package keytypes
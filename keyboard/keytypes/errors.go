@@ -0,0 +1,134 @@
+package keytypes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors reported by keyboard.Sender and keyboard.Listener and by
+// the fakes standing in for them. Failures are returned as *OpError values
+// wrapping one of these, so callers can branch with errors.Is and recover
+// the details with errors.As.
+var (
+	// ErrSenderClosed is returned when a Sender is used after Close.
+	ErrSenderClosed = errors.New("sender is closed")
+
+	// ErrListenerClosed is returned when a Listener is used after Close.
+	ErrListenerClosed = errors.New("listener is closed")
+
+	// ErrAlreadyListening is returned when Listener.Start is called on a
+	// running listener.
+	ErrAlreadyListening = errors.New("listener is already running")
+
+	// ErrListenerTerminated is reported when the native listener stops on
+	// its own, for example because the device was removed or permissions
+	// were revoked.
+	ErrListenerTerminated = errors.New("listener terminated unexpectedly")
+
+	// ErrNilCallback is returned when Listener.Start is given a nil callback.
+	ErrNilCallback = errors.New("callback cannot be nil")
+
+	// ErrPermission is returned when the backend lacks the permissions or
+	// device access it needs (accessibility, input monitoring, uinput).
+	ErrPermission = errors.New("permission denied")
+
+	// ErrUnsupported is returned when the active backend does not support
+	// the requested operation.
+	ErrUnsupported = errors.New("operation not supported by backend")
+
+	// ErrNoMapping is returned when the backend has no mapping for the
+	// requested key or codepoint in the current layout.
+	ErrNoMapping = errors.New("no mapping for key or codepoint")
+
+	// ErrCallbackPanic is wrapped by the *PanicError reported when a
+	// listener callback panics.
+	ErrCallbackPanic = errors.New("listener callback panicked")
+
+	// ErrPanicLimit is reported when a Listener stops itself after its
+	// callback panicked too many times in a row (see SetPanicLimit).
+	ErrPanicLimit = errors.New("listener stopped after repeated callback panics")
+
+	// ErrUnknownKey is returned when a key name cannot be decoded.
+	ErrUnknownKey = errors.New("unknown key name")
+
+	// ErrInvalidChord is wrapped by the *ChordParseError returned when
+	// ParseChord rejects its input.
+	ErrInvalidChord = errors.New("invalid chord")
+
+	// ErrFailed is returned when the backend reports a failure that does not
+	// match a more specific cause.
+	ErrFailed = errors.New("operation failed")
+)
+
+// OpError describes a failed keyboard.Sender or keyboard.Listener operation.
+type OpError struct {
+	// Op is the operation that failed, such as "tap" or "type text".
+	Op string
+
+	// Key is the key involved, or 0 if the operation has none.
+	Key Key
+
+	// Codepoint is the codepoint involved, or 0 if the operation has none.
+	Codepoint rune
+
+	// Backend is the active backend type, as reported by Sender.BackendType.
+	Backend uint8
+
+	// Message is the native library's error message, if it reported one.
+	Message string
+
+	// Err is the cause: one of the sentinel errors in this package, or the
+	// context's error for operations cancelled through a Context variant.
+	Err error
+}
+
+// Error returns a description of the failure, including the native message.
+func (e *OpError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Op)
+	if e.Key != 0 {
+		fmt.Fprintf(&b, " key=%s", KeyToString(e.Key))
+	}
+	if e.Codepoint != 0 {
+		fmt.Fprintf(&b, " codepoint=%U", e.Codepoint)
+	}
+	b.WriteString(": ")
+	b.WriteString(e.Err.Error())
+	if e.Message != "" && e.Message != e.Err.Error() {
+		b.WriteString(": ")
+		b.WriteString(e.Message)
+	}
+	return b.String()
+}
+
+// Unwrap returns the cause.
+func (e *OpError) Unwrap() error { return e.Err }
+
+// PanicError reports a panic recovered from a listener callback or from a
+// hotkey or gesture handler.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the goroutine stack captured when the panic was recovered.
+	Stack []byte
+
+	// Event is the event the callback was handling; it is zero for
+	// handlers, which do not run on behalf of a single event.
+	Event KeyEvent
+}
+
+// Error returns a description of the panic.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrCallbackPanic, e.Value)
+}
+
+// Unwrap returns ErrCallbackPanic and, if the panic value is an error, that
+// error too.
+func (e *PanicError) Unwrap() []error {
+	if err, ok := e.Value.(error); ok {
+		return []error{ErrCallbackPanic, err}
+	}
+	return []error{ErrCallbackPanic}
+}
@@ -0,0 +1,206 @@
+package keytypes
+
+// EvdevCode returns the Linux input event code (a KEY_* value from
+// linux/input-event-codes.h) for the key, as reported by evtest and used in
+// udev hwdb entries. It returns false for keys with no physical key of their
+// own, such as the shifted symbols (KeyAt, KeyQuestionMark) and the control
+// character keys.
+func (k Key) EvdevCode() (uint16, bool) {
+	return evdevTable.code(k)
+}
+
+// KeyFromEvdevCode returns the key for a Linux KEY_* input event code, or
+// KeyUnknown and false if the code has no Key.
+func KeyFromEvdevCode(code uint16) (Key, bool) {
+	return evdevTable.key(code)
+}
+
+var evdevTable = newCodeTable([]codePair[uint16]{
+	{KeyEscape, 1},
+	{Key1, 2},
+	{Key2, 3},
+	{Key3, 4},
+	{Key4, 5},
+	{Key5, 6},
+	{Key6, 7},
+	{Key7, 8},
+	{Key8, 9},
+	{Key9, 10},
+	{Key0, 11},
+	{KeyMinus, 12},
+	{KeyEqual, 13},
+	{KeyBackspace, 14},
+	{KeyTab, 15},
+	{KeyQ, 16},
+	{KeyW, 17},
+	{KeyE, 18},
+	{KeyR, 19},
+	{KeyT, 20},
+	{KeyY, 21},
+	{KeyU, 22},
+	{KeyI, 23},
+	{KeyO, 24},
+	{KeyP, 25},
+	{KeyLeftBracket, 26},
+	{KeyRightBracket, 27},
+	{KeyEnter, 28},
+	{KeyCtrlLeft, 29},
+	{KeyA, 30},
+	{KeyS, 31},
+	{KeyD, 32},
+	{KeyF, 33},
+	{KeyG, 34},
+	{KeyH, 35},
+	{KeyJ, 36},
+	{KeyK, 37},
+	{KeyL, 38},
+	{KeySemicolon, 39},
+	{KeyApostrophe, 40},
+	{KeyGrave, 41},
+	{KeyShiftLeft, 42},
+	{KeyBackslash, 43},
+	{KeyZ, 44},
+	{KeyX, 45},
+	{KeyC, 46},
+	{KeyV, 47},
+	{KeyB, 48},
+	{KeyN, 49},
+	{KeyM, 50},
+	{KeyComma, 51},
+	{KeyPeriod, 52},
+	{KeySlash, 53},
+	{KeyShiftRight, 54},
+	{KeyNumpadMultiply, 55},
+	{KeyAltLeft, 56},
+	{KeySpace, 57},
+	{KeyCapsLock, 58},
+	{KeyF1, 59},
+	{KeyF2, 60},
+	{KeyF3, 61},
+	{KeyF4, 62},
+	{KeyF5, 63},
+	{KeyF6, 64},
+	{KeyF7, 65},
+	{KeyF8, 66},
+	{KeyF9, 67},
+	{KeyF10, 68},
+	{KeyNumLock, 69},
+	{KeyScrollLock, 70},
+	{KeyNumpad7, 71},
+	{KeyNumpad8, 72},
+	{KeyNumpad9, 73},
+	{KeyNumpadMinus, 74},
+	{KeyNumpad4, 75},
+	{KeyNumpad5, 76},
+	{KeyNumpad6, 77},
+	{KeyNumpadPlus, 78},
+	{KeyNumpad1, 79},
+	{KeyNumpad2, 80},
+	{KeyNumpad3, 81},
+	{KeyNumpad0, 82},
+	{KeyNumpadDecimal, 83},
+	{KeyF11, 87},
+	{KeyF12, 88},
+	{KeyKatakana, 90},
+	{KeyHiragana, 91},
+	{KeyHenkan, 92},
+	{KeyMuhenkan, 94},
+	{KeyNumpadEnter, 96},
+	{KeyCtrlRight, 97},
+	{KeyNumpadDivide, 98},
+	{KeyPrintScreen, 99},
+	{KeyAltRight, 100},
+	{KeyHome, 102},
+	{KeyUp, 103},
+	{KeyPageUp, 104},
+	{KeyLeft, 105},
+	{KeyRight, 106},
+	{KeyEnd, 107},
+	{KeyDown, 108},
+	{KeyPageDown, 109},
+	{KeyInsert, 110},
+	{KeyDelete, 111},
+	{KeyMute, 113},
+	{KeyVolumeDown, 114},
+	{KeyVolumeUp, 115},
+	{KeyPower, 116},
+	{KeyNumpadEqual, 117},
+	{KeyPause, 119},
+	{KeyLaunchA, 120},
+	{KeyHangul, 122},
+	{KeyHangulHanja, 123},
+	{KeySuperLeft, 125},
+	{KeySuperRight, 126},
+	{KeyMenu, 127},
+	{KeySunProps, 130},
+	{KeyUndo, 131},
+	{KeySunFront, 132},
+	{KeyCopy, 133},
+	{KeyOpen, 134},
+	{KeyPaste, 135},
+	{KeyFind, 136},
+	{KeyCut, 137},
+	{KeyHelp, 138},
+	{KeyCalculator, 140},
+	{KeySleep, 142},
+	{KeyWake, 143},
+	{KeyExplorer, 144},
+	{KeyXfer, 147},
+	{KeyLaunch1, 148},
+	{KeyLaunch2, 149},
+	{KeyMail, 155},
+	{KeyEject, 161},
+	{KeyMediaNext, 163},
+	{KeyMediaPlayPause, 164},
+	{KeyMediaPrevious, 165},
+	{KeyMediaStop, 166},
+	{KeyAudioRecord, 167},
+	{KeyAudioRewind, 168},
+	{KeyPhone, 169},
+	{KeyHomePage, 172},
+	{KeyReload, 173},
+	{KeyRedo, 182},
+	{KeyF13, 183},
+	{KeyF14, 184},
+	{KeyF15, 185},
+	{KeyF16, 186},
+	{KeyF17, 187},
+	{KeyF18, 188},
+	{KeyF19, 189},
+	{KeyF20, 190},
+	{KeyLaunch3, 202},
+	{KeyLaunch4, 203},
+	{KeyLaunchB, 204},
+	{KeyClose, 206},
+	{KeyWebCam, 212},
+	{KeyMessenger, 216},
+	{KeySearch, 217},
+	{KeyGo, 218},
+	{KeyFinance, 219},
+	{KeyGame, 220},
+	{KeyShop, 221},
+	{KeyCancel, 223},
+	{KeyBrightnessDown, 224},
+	{KeyBrightnessUp, 225},
+	{KeyKbdLightOnOff, 228},
+	{KeyKbdBrightnessDown, 229},
+	{KeyKbdBrightnessUp, 230},
+	{KeySend, 231},
+	{KeyMailForward, 233},
+	{KeySave, 234},
+	{KeyDocuments, 235},
+	{KeyBattery, 236},
+	{KeyBluetooth, 237},
+	{KeyWLAN, 238},
+	{KeyUWB, 239},
+	{KeyNextVMode, 241},
+	{KeyPrevVMode, 242},
+	{KeyMonBrightnessCycle, 243},
+	{KeyBrightnessAuto, 244},
+	{KeyDisplayOff, 245},
+	{KeyWWAN, 246},
+	{KeyRFKill, 247},
+	{KeyTouchpadToggle, 530},
+	{KeyTouchpadOn, 531},
+	{KeyTouchpadOff, 532},
+})
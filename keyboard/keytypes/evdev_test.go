@@ -0,0 +1,81 @@
+package keytypes
+
+import "testing"
+
+// codeCase is a key and the code a platform header publishes for it.
+type codeCase struct {
+	key  Key
+	code uint16
+}
+
+// checkCodes checks a code table against published values: each key in
+// want maps to its code and back.
+func checkCodes(t *testing.T, name string, want []codeCase, toCode func(Key) (uint16, bool), toKey func(uint16) (Key, bool)) {
+	t.Helper()
+	for _, c := range want {
+		if got, ok := toCode(c.key); !ok || got != c.code {
+			t.Errorf("%v %s = %#x, %v; want %#x, true", c.key, name, got, ok, c.code)
+		}
+		if got, ok := toKey(c.code); !ok || got != c.key {
+			t.Errorf("key for %s %#x = %v, %v; want %v, true", name, c.code, got, ok, c.key)
+		}
+	}
+}
+
+// checkCodeTable checks that every code in table maps to a key whose own
+// code maps back to that key, and that every key with a code is found
+// again from it.
+func checkCodeTable(t *testing.T, name string, table *codeTable[uint16], toCode func(Key) (uint16, bool), toKey func(uint16) (Key, bool)) {
+	t.Helper()
+	if len(table.keys) == 0 {
+		t.Fatalf("empty %s table", name)
+	}
+	for code, k := range table.keys {
+		if got, ok := toKey(code); !ok || got != k {
+			t.Errorf("key for %s %#x = %v, %v; want %v, true", name, code, got, ok, k)
+		}
+		primary, ok := toCode(k)
+		if !ok {
+			t.Errorf("%v has no %s, but %#x maps to it", k, name, code)
+			continue
+		}
+		if back, _ := toKey(primary); back != k {
+			t.Errorf("%v %s = %#x, which maps back to %v", k, name, primary, back)
+		}
+	}
+	for _, k := range AllKeys() {
+		code, ok := toCode(k)
+		if !ok {
+			continue
+		}
+		if back, ok := toKey(code); !ok {
+			t.Errorf("%v %s = %#x, which maps to no key", k, name, code)
+		} else if c, _ := toCode(back); c != code {
+			t.Errorf("%v %s = %#x, which maps to %v with %#x", k, name, code, back, c)
+		}
+	}
+	if _, ok := toCode(KeyUnknown); ok {
+		t.Errorf("KeyUnknown has a %s", name)
+	}
+}
+
+func TestEvdevCodes(t *testing.T) {
+	// Values from linux/input-event-codes.h.
+	checkCodes(t, "evdev code", []codeCase{
+		{KeyEscape, 1},      // KEY_ESC
+		{KeyEnter, 28},      // KEY_ENTER
+		{KeyA, 30},          // KEY_A
+		{KeyShiftLeft, 42},  // KEY_LEFTSHIFT
+		{KeySpace, 57},      // KEY_SPACE
+		{KeyF1, 59},         // KEY_F1
+		{KeyNumpad5, 76},    // KEY_KP5
+		{KeyAltRight, 100},  // KEY_RIGHTALT
+		{KeyHome, 102},      // KEY_HOME
+		{KeyVolumeUp, 115},  // KEY_VOLUMEUP
+		{KeySuperLeft, 125}, // KEY_LEFTMETA
+	}, Key.EvdevCode, KeyFromEvdevCode)
+	checkCodeTable(t, "evdev code", evdevTable, Key.EvdevCode, KeyFromEvdevCode)
+	if k, ok := KeyFromEvdevCode(0); ok || k != KeyUnknown {
+		t.Errorf("KeyFromEvdevCode(KEY_RESERVED) = %v, %v; want Unknown, false", k, ok)
+	}
+}
@@ -0,0 +1,57 @@
+package keytypes
+
+import "time"
+
+// ListenerCallback is the function signature for keyboard event callbacks.
+// The callback may be invoked from an internal thread and must be thread-safe.
+type ListenerCallback func(event KeyEvent)
+
+// KeyEvent represents a keyboard event received by the listener.
+type KeyEvent struct {
+	// Codepoint is the Unicode codepoint produced by the event (0 if none).
+	Codepoint uint32
+
+	// Key is the logical key ID (0 if unknown).
+	Key Key
+
+	// Modifiers is the current modifier bitmask as reported by the backend,
+	// with each held key assigned with SetModifierKeys reported as its
+	// extended bit (ModAltGr, ModMeta, ModHyper) instead.
+	Modifiers Modifier
+
+	// Sides holds the side-specific bit (ModLeftShift, ModRightAlt, ...) of
+	// each modifier key the listener has seen pressed and not yet released.
+	// It is kept out of Modifiers so that comparisons such as
+	// event.Modifiers == keyboard.ModCtrl keep working; use
+	// Modifiers|Sides where the side matters.
+	Sides Modifier
+
+	// Pressed is true for key press, false for key release.
+	Pressed bool
+
+	// Time is when the event reached the bindings, captured on entry to the
+	// native callback. It carries a monotonic clock reading, so differences
+	// between events are immune to wall-clock changes.
+	Time time.Time
+
+	// Seq is the event's position in its listener's stream, starting at 1.
+	// Gaps reveal events discarded downstream.
+	Seq uint64
+
+	// HoldDuration is, for release events, how long the key was held since
+	// its first press (repeats do not restart it). It is 0 for press events
+	// and for releases whose press was not observed.
+	HoldDuration time.Duration
+}
+
+// IsPress returns true if this is a key press event.
+func (e KeyEvent) IsPress() bool { return e.Pressed }
+
+// IsRelease returns true if this is a key release event.
+func (e KeyEvent) IsRelease() bool { return !e.Pressed }
+
+// Rune returns the Unicode rune for this event, or 0 if none.
+func (e KeyEvent) Rune() rune { return rune(e.Codepoint) }
+
+// KeyName returns the canonical name of the key.
+func (e KeyEvent) KeyName() string { return KeyToString(e.Key) }
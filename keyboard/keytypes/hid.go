@@ -0,0 +1,267 @@
+package keytypes
+
+// HID usage pages covered by Key.HIDUsage.
+const (
+	// HIDPageKeyboard is the Keyboard/Keypad usage page (0x07).
+	HIDPageKeyboard uint16 = 0x07
+
+	// HIDPageConsumer is the Consumer usage page (0x0C), which carries media,
+	// volume, brightness and application-control keys.
+	HIDPageConsumer uint16 = 0x0C
+)
+
+// HIDUsage identifies a key as a USB HID usage: a usage page and a usage ID
+// within it.
+type HIDUsage struct {
+	Page uint16
+	ID   uint16
+}
+
+// HIDUsage returns the USB HID usage for the key. Media and editing keys
+// that have a usage on both pages, such as KeyMute, map to the Consumer
+// page, which hosts support more widely. It returns false for keys with no
+// usage, such as the shifted symbols and the control character keys.
+func (k Key) HIDUsage() (HIDUsage, bool) {
+	return hidTable.code(k)
+}
+
+// KeyFromHIDUsage returns the key for a USB HID usage on the Keyboard/Keypad
+// or Consumer page, or KeyUnknown and false if the usage has no Key.
+func KeyFromHIDUsage(u HIDUsage) (Key, bool) {
+	return hidTable.key(u)
+}
+
+// HID modifier byte bits, as sent in the first byte of a boot-protocol
+// keyboard report.
+const (
+	hidLeftCtrl   = 1 << 0
+	hidLeftShift  = 1 << 1
+	hidLeftAlt    = 1 << 2
+	hidLeftGUI    = 1 << 3
+	hidRightCtrl  = 1 << 4
+	hidRightShift = 1 << 5
+	hidRightAlt   = 1 << 6
+	hidRightGUI   = 1 << 7
+)
+
+// hidModifierBits pairs each side-specific modifier with its bit in the HID
+// modifier byte.
+var hidModifierBits = []struct {
+	side Modifier
+	bit  byte
+}{
+	{ModLeftCtrl, hidLeftCtrl},
+	{ModLeftShift, hidLeftShift},
+	{ModLeftAlt, hidLeftAlt},
+	{ModLeftSuper, hidLeftGUI},
+	{ModRightCtrl, hidRightCtrl},
+	{ModRightShift, hidRightShift},
+	{ModRightAlt, hidRightAlt},
+	{ModRightSuper, hidRightGUI},
+}
+
+// HIDModifiers returns the boot-protocol HID modifier byte for m.
+// Side-specific bits set that side's bit; a generic bit with no
+// side-specific bit sets the left-hand bit. Lock modifiers have no bit in
+// the byte and are ignored, as are the extended modifiers.
+func (m Modifier) HIDModifiers() byte {
+	var sides Modifier
+	for _, k := range m.Keys() {
+		sides |= k.SideModifierBit()
+	}
+	var b byte
+	for _, h := range hidModifierBits {
+		if sides&h.side != 0 {
+			b |= h.bit
+		}
+	}
+	return b
+}
+
+// ModifierFromHID returns the modifiers set in a boot-protocol HID modifier
+// byte, with both the side-specific and the generic bit for each.
+func ModifierFromHID(b byte) Modifier {
+	var m Modifier
+	for _, h := range hidModifierBits {
+		if b&h.bit != 0 {
+			m |= h.side
+		}
+	}
+	return m.Normalize()
+}
+
+func hidKbd(id uint16) HIDUsage { return HIDUsage{HIDPageKeyboard, id} }
+func hidCsm(id uint16) HIDUsage { return HIDUsage{HIDPageConsumer, id} }
+
+// hidTable lists each key's preferred usage first; the alternative usages
+// at the end map back to their key but are never returned by HIDUsage.
+var hidTable = newCodeTable([]codePair[HIDUsage]{
+	{KeyA, hidKbd(0x04)},
+	{KeyB, hidKbd(0x05)},
+	{KeyC, hidKbd(0x06)},
+	{KeyD, hidKbd(0x07)},
+	{KeyE, hidKbd(0x08)},
+	{KeyF, hidKbd(0x09)},
+	{KeyG, hidKbd(0x0A)},
+	{KeyH, hidKbd(0x0B)},
+	{KeyI, hidKbd(0x0C)},
+	{KeyJ, hidKbd(0x0D)},
+	{KeyK, hidKbd(0x0E)},
+	{KeyL, hidKbd(0x0F)},
+	{KeyM, hidKbd(0x10)},
+	{KeyN, hidKbd(0x11)},
+	{KeyO, hidKbd(0x12)},
+	{KeyP, hidKbd(0x13)},
+	{KeyQ, hidKbd(0x14)},
+	{KeyR, hidKbd(0x15)},
+	{KeyS, hidKbd(0x16)},
+	{KeyT, hidKbd(0x17)},
+	{KeyU, hidKbd(0x18)},
+	{KeyV, hidKbd(0x19)},
+	{KeyW, hidKbd(0x1A)},
+	{KeyX, hidKbd(0x1B)},
+	{KeyY, hidKbd(0x1C)},
+	{KeyZ, hidKbd(0x1D)},
+	{Key1, hidKbd(0x1E)},
+	{Key2, hidKbd(0x1F)},
+	{Key3, hidKbd(0x20)},
+	{Key4, hidKbd(0x21)},
+	{Key5, hidKbd(0x22)},
+	{Key6, hidKbd(0x23)},
+	{Key7, hidKbd(0x24)},
+	{Key8, hidKbd(0x25)},
+	{Key9, hidKbd(0x26)},
+	{Key0, hidKbd(0x27)},
+	{KeyEnter, hidKbd(0x28)},
+	{KeyEscape, hidKbd(0x29)},
+	{KeyBackspace, hidKbd(0x2A)},
+	{KeyTab, hidKbd(0x2B)},
+	{KeySpace, hidKbd(0x2C)},
+	{KeyMinus, hidKbd(0x2D)},
+	{KeyEqual, hidKbd(0x2E)},
+	{KeyLeftBracket, hidKbd(0x2F)},
+	{KeyRightBracket, hidKbd(0x30)},
+	{KeyBackslash, hidKbd(0x31)},
+	{KeySemicolon, hidKbd(0x33)},
+	{KeyApostrophe, hidKbd(0x34)},
+	{KeyGrave, hidKbd(0x35)},
+	{KeyComma, hidKbd(0x36)},
+	{KeyPeriod, hidKbd(0x37)},
+	{KeySlash, hidKbd(0x38)},
+	{KeyCapsLock, hidKbd(0x39)},
+	{KeyF1, hidKbd(0x3A)},
+	{KeyF2, hidKbd(0x3B)},
+	{KeyF3, hidKbd(0x3C)},
+	{KeyF4, hidKbd(0x3D)},
+	{KeyF5, hidKbd(0x3E)},
+	{KeyF6, hidKbd(0x3F)},
+	{KeyF7, hidKbd(0x40)},
+	{KeyF8, hidKbd(0x41)},
+	{KeyF9, hidKbd(0x42)},
+	{KeyF10, hidKbd(0x43)},
+	{KeyF11, hidKbd(0x44)},
+	{KeyF12, hidKbd(0x45)},
+	{KeyPrintScreen, hidKbd(0x46)},
+	{KeyScrollLock, hidKbd(0x47)},
+	{KeyPause, hidKbd(0x48)},
+	{KeyInsert, hidKbd(0x49)},
+	{KeyHome, hidKbd(0x4A)},
+	{KeyPageUp, hidKbd(0x4B)},
+	{KeyDelete, hidKbd(0x4C)},
+	{KeyEnd, hidKbd(0x4D)},
+	{KeyPageDown, hidKbd(0x4E)},
+	{KeyRight, hidKbd(0x4F)},
+	{KeyLeft, hidKbd(0x50)},
+	{KeyDown, hidKbd(0x51)},
+	{KeyUp, hidKbd(0x52)},
+	{KeyNumLock, hidKbd(0x53)},
+	{KeyNumpadDivide, hidKbd(0x54)},
+	{KeyNumpadMultiply, hidKbd(0x55)},
+	{KeyNumpadMinus, hidKbd(0x56)},
+	{KeyNumpadPlus, hidKbd(0x57)},
+	{KeyNumpadEnter, hidKbd(0x58)},
+	{KeyNumpad1, hidKbd(0x59)},
+	{KeyNumpad2, hidKbd(0x5A)},
+	{KeyNumpad3, hidKbd(0x5B)},
+	{KeyNumpad4, hidKbd(0x5C)},
+	{KeyNumpad5, hidKbd(0x5D)},
+	{KeyNumpad6, hidKbd(0x5E)},
+	{KeyNumpad7, hidKbd(0x5F)},
+	{KeyNumpad8, hidKbd(0x60)},
+	{KeyNumpad9, hidKbd(0x61)},
+	{KeyNumpad0, hidKbd(0x62)},
+	{KeyNumpadDecimal, hidKbd(0x63)},
+	{KeyMenu, hidKbd(0x65)},
+	{KeyPower, hidKbd(0x66)},
+	{KeyNumpadEqual, hidKbd(0x67)},
+	{KeyF13, hidKbd(0x68)},
+	{KeyF14, hidKbd(0x69)},
+	{KeyF15, hidKbd(0x6A)},
+	{KeyF16, hidKbd(0x6B)},
+	{KeyF17, hidKbd(0x6C)},
+	{KeyF18, hidKbd(0x6D)},
+	{KeyF19, hidKbd(0x6E)},
+	{KeyF20, hidKbd(0x6F)},
+	{KeyHelp, hidKbd(0x75)},
+	{KeyHenkan, hidKbd(0x8A)},
+	{KeyMuhenkan, hidKbd(0x8B)},
+	{KeyHangul, hidKbd(0x90)},
+	{KeyHangulHanja, hidKbd(0x91)},
+	{KeyKatakana, hidKbd(0x92)},
+	{KeyHiragana, hidKbd(0x93)},
+	{KeyCancel, hidKbd(0x9B)},
+	{KeyCtrlLeft, hidKbd(0xE0)},
+	{KeyShiftLeft, hidKbd(0xE1)},
+	{KeyAltLeft, hidKbd(0xE2)},
+	{KeySuperLeft, hidKbd(0xE3)},
+	{KeyCtrlRight, hidKbd(0xE4)},
+	{KeyShiftRight, hidKbd(0xE5)},
+	{KeyAltRight, hidKbd(0xE6)},
+	{KeySuperRight, hidKbd(0xE7)},
+
+	{KeySleep, hidCsm(0x32)},
+	{KeyBrightnessUp, hidCsm(0x6F)},
+	{KeyBrightnessDown, hidCsm(0x70)},
+	{KeyKbdBrightnessUp, hidCsm(0x79)},
+	{KeyKbdBrightnessDown, hidCsm(0x7A)},
+	{KeyKbdLightOnOff, hidCsm(0x7C)},
+	{KeyAudioRecord, hidCsm(0xB2)},
+	{KeyAudioRewind, hidCsm(0xB4)},
+	{KeyMediaNext, hidCsm(0xB5)},
+	{KeyMediaPrevious, hidCsm(0xB6)},
+	{KeyMediaStop, hidCsm(0xB7)},
+	{KeyEject, hidCsm(0xB8)},
+	{KeyMediaPlayPause, hidCsm(0xCD)},
+	{KeyMute, hidCsm(0xE2)},
+	{KeyVolumeUp, hidCsm(0xE9)},
+	{KeyVolumeDown, hidCsm(0xEA)},
+	{KeyMail, hidCsm(0x18A)},
+	{KeyCalculator, hidCsm(0x192)},
+	{KeyExplorer, hidCsm(0x194)},
+	{KeyMessenger, hidCsm(0x1BC)},
+	{KeyOpen, hidCsm(0x202)},
+	{KeyClose, hidCsm(0x203)},
+	{KeySave, hidCsm(0x207)},
+	{KeyUndo, hidCsm(0x21A)},
+	{KeyCopy, hidCsm(0x21B)},
+	{KeyCut, hidCsm(0x21C)},
+	{KeyPaste, hidCsm(0x21D)},
+	{KeyFind, hidCsm(0x21F)},
+	{KeySearch, hidCsm(0x221)},
+	{KeyHomePage, hidCsm(0x223)},
+	{KeyReload, hidCsm(0x227)},
+	{KeyRedo, hidCsm(0x279)},
+	{KeyMailForward, hidCsm(0x28B)},
+	{KeySend, hidCsm(0x28C)},
+
+	// Alternative usages.
+	{KeyUndo, hidKbd(0x7A)},
+	{KeyCut, hidKbd(0x7B)},
+	{KeyCopy, hidKbd(0x7C)},
+	{KeyPaste, hidKbd(0x7D)},
+	{KeyFind, hidKbd(0x7E)},
+	{KeyMute, hidKbd(0x7F)},
+	{KeyVolumeUp, hidKbd(0x80)},
+	{KeyVolumeDown, hidKbd(0x81)},
+	{KeyPower, hidCsm(0x30)},
+})
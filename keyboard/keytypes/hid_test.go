@@ -0,0 +1,126 @@
+package keytypes
+
+import "testing"
+
+func TestHIDUsageRoundTrip(t *testing.T) {
+	for _, page := range []uint16{HIDPageKeyboard, HIDPageConsumer} {
+		var n int
+		for u, k := range hidTable.keys {
+			if u.Page != page {
+				continue
+			}
+			n++
+			got, ok := KeyFromHIDUsage(u)
+			if !ok || got != k {
+				t.Errorf("KeyFromHIDUsage(%#v) = %v, %v; want %v, true", u, got, ok, k)
+				continue
+			}
+			primary, ok := k.HIDUsage()
+			if !ok {
+				t.Errorf("%v.HIDUsage() = _, false; want the usage of %#v", k, u)
+				continue
+			}
+			if back, _ := KeyFromHIDUsage(primary); back != k {
+				t.Errorf("%v.HIDUsage() = %#v, which maps back to %v", k, primary, back)
+			}
+		}
+		if n == 0 {
+			t.Errorf("no usages on page %#x", page)
+		}
+	}
+}
+
+func TestHIDUsageSpec(t *testing.T) {
+	// Values from the USB HID Usage Tables, sections 10 (Keyboard/Keypad)
+	// and 15 (Consumer).
+	tests := []struct {
+		key   Key
+		usage HIDUsage
+	}{
+		{KeyA, HIDUsage{0x07, 0x04}},
+		{Key0, HIDUsage{0x07, 0x27}},
+		{KeyEnter, HIDUsage{0x07, 0x28}},
+		{KeyEscape, HIDUsage{0x07, 0x29}},
+		{KeyBackspace, HIDUsage{0x07, 0x2A}},
+		{KeyTab, HIDUsage{0x07, 0x2B}},
+		{KeySpace, HIDUsage{0x07, 0x2C}},
+		{KeyF1, HIDUsage{0x07, 0x3A}},
+		{KeyRight, HIDUsage{0x07, 0x4F}},
+		{KeyNumpad5, HIDUsage{0x07, 0x5D}},
+		{KeyCtrlLeft, HIDUsage{0x07, 0xE0}},
+		{KeyShiftLeft, HIDUsage{0x07, 0xE1}},
+		{KeyAltRight, HIDUsage{0x07, 0xE6}},
+		{KeySuperRight, HIDUsage{0x07, 0xE7}},
+		{KeyMute, HIDUsage{0x0C, 0xE2}},
+		{KeyVolumeUp, HIDUsage{0x0C, 0xE9}},
+		{KeyVolumeDown, HIDUsage{0x0C, 0xEA}},
+	}
+	for _, tt := range tests {
+		if got, ok := tt.key.HIDUsage(); !ok || got != tt.usage {
+			t.Errorf("%v.HIDUsage() = %#v, %v; want %#v, true", tt.key, got, ok, tt.usage)
+		}
+		if got, ok := KeyFromHIDUsage(tt.usage); !ok || got != tt.key {
+			t.Errorf("KeyFromHIDUsage(%#v) = %v, %v; want %v, true", tt.usage, got, ok, tt.key)
+		}
+	}
+
+	// The Keyboard page's volume usages resolve to the same keys as the
+	// Consumer page's.
+	for id, want := range map[uint16]Key{0x7F: KeyMute, 0x80: KeyVolumeUp, 0x81: KeyVolumeDown} {
+		if got, ok := KeyFromHIDUsage(HIDUsage{0x07, id}); !ok || got != want {
+			t.Errorf("KeyFromHIDUsage(keyboard %#x) = %v, %v; want %v, true", id, got, ok, want)
+		}
+	}
+	if got, ok := KeyFromHIDUsage(HIDUsage{0x07, 0x00}); ok || got != KeyUnknown {
+		t.Errorf("KeyFromHIDUsage(keyboard 0x00) = %v, %v; want Unknown, false", got, ok)
+	}
+}
+
+func TestKeyHIDUsageRoundTrip(t *testing.T) {
+	for _, k := range AllKeys() {
+		u, ok := k.HIDUsage()
+		if !ok {
+			continue
+		}
+		if u.Page != HIDPageKeyboard && u.Page != HIDPageConsumer {
+			t.Errorf("%v.HIDUsage() = %#v, on an unexpected page", k, u)
+		}
+		if got, ok := KeyFromHIDUsage(u); !ok || got != k {
+			t.Errorf("KeyFromHIDUsage(%v.HIDUsage()) = %v, %v; want %v, true", k, got, ok, k)
+		}
+	}
+}
+
+func TestHIDModifiersRoundTrip(t *testing.T) {
+	for i := 0; i < 256; i++ {
+		b := byte(i)
+		m := ModifierFromHID(b)
+		if got := m.HIDModifiers(); got != b {
+			t.Errorf("ModifierFromHID(%#02x).HIDModifiers() = %#02x (from %v)", b, got, m)
+		}
+		if m != m.Normalize() {
+			t.Errorf("ModifierFromHID(%#02x) = %v, missing generic bits", b, m)
+		}
+	}
+}
+
+func TestHIDModifiersGeneric(t *testing.T) {
+	tests := []struct {
+		mods Modifier
+		want byte
+	}{
+		{0, 0},
+		{ModCtrl, hidLeftCtrl},
+		{ModShift | ModAlt, hidLeftShift | hidLeftAlt},
+		{ModSuper, hidLeftGUI},
+		{ModRightAlt, hidRightAlt},
+		{ModCtrl | ModRightCtrl, hidRightCtrl},
+		{ModLeftShift | ModRightShift, hidLeftShift | hidRightShift},
+		{ModCapsLock | ModNumLock, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.mods.HIDModifiers(); got != tt.want {
+			t.Errorf("%v.HIDModifiers() = %#02x, want %#02x", tt.mods, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package keytypes
+
+// Injector is the behaviour shared by keyboard input injection backends.
+// keyboard.Sender satisfies it; alternative backends, decorators and test doubles
+// can implement it to stand in for the native sender.
+type Injector interface {
+	// KeyDown simulates a physical key press.
+	KeyDown(key Key) error
+
+	// KeyUp simulates a physical key release.
+	KeyUp(key Key) error
+
+	// Tap simulates a key tap (press then release).
+	Tap(key Key) error
+
+	// Combo presses modifiers, taps key, then releases the modifiers.
+	Combo(mods Modifier, key Key) error
+
+	// TypeText injects UTF-8 text.
+	TypeText(text string) error
+
+	// TypeCharacter injects a single Unicode codepoint.
+	TypeCharacter(codepoint rune) error
+
+	// HoldModifier presses the specified modifier keys.
+	HoldModifier(mods Modifier) error
+
+	// ReleaseModifier releases the specified modifier keys.
+	ReleaseModifier(mods Modifier) error
+
+	// ReleaseAllModifiers releases all currently held modifiers.
+	ReleaseAllModifiers() error
+
+	// ActiveModifiers returns the currently active modifiers.
+	ActiveModifiers() Modifier
+
+	// Flush forces delivery of pending keyboard events.
+	Flush()
+
+	// Close releases the resources held by the backend.
+	Close()
+}
+
+// EventSource is the behaviour shared by global keyboard event sources.
+// keyboard.Listener satisfies it.
+type EventSource interface {
+	// Start begins delivering keyboard events to callback.
+	Start(callback ListenerCallback) error
+
+	// Stop stops delivering keyboard events; no-op if not running.
+	Stop()
+
+	// IsListening returns true if the source is currently active.
+	IsListening() bool
+
+	// Close releases the resources held by the source.
+	Close()
+}
@@ -0,0 +1,142 @@
+package keytypes
+
+// KeyCategory groups keys by their role on a keyboard.
+type KeyCategory uint8
+
+// Key categories returned by Key.Category.
+const (
+	// CategoryUnknown is the category of KeyUnknown and of ids that are not in
+	// the key table.
+	CategoryUnknown KeyCategory = iota
+
+	// CategoryLetter covers KeyA through KeyZ.
+	CategoryLetter
+
+	// CategoryDigit covers the main-row digits Key0 through Key9.
+	CategoryDigit
+
+	// CategoryFunction covers KeyF1 through KeyF20.
+	CategoryFunction
+
+	// CategoryNavigation covers the arrow keys, Home, End, PageUp and
+	// PageDown.
+	CategoryNavigation
+
+	// CategoryEditing covers Enter, Tab, Space, Backspace, Delete, Insert and
+	// the clipboard and undo keys.
+	CategoryEditing
+
+	// CategoryNumpad covers the numeric keypad.
+	CategoryNumpad
+
+	// CategoryMedia covers volume, playback and audio keys.
+	CategoryMedia
+
+	// CategoryModifier covers the left and right Shift, Ctrl, Alt and Super
+	// keys.
+	CategoryModifier
+
+	// CategoryLock covers CapsLock, NumLock and ScrollLock.
+	CategoryLock
+
+	// CategorySymbol covers punctuation and other symbol keys, including the
+	// shifted symbols and dead keys.
+	CategorySymbol
+
+	// CategoryControl covers the ASCII control character keys (NUL through
+	// US).
+	CategoryControl
+
+	// CategorySystem covers the remaining special-purpose keys: Escape,
+	// PrintScreen, Pause, power and brightness controls, launchers and
+	// language input keys.
+	CategorySystem
+)
+
+var categoryNames = [...]string{
+	CategoryUnknown:    "Unknown",
+	CategoryLetter:     "Letter",
+	CategoryDigit:      "Digit",
+	CategoryFunction:   "Function",
+	CategoryNavigation: "Navigation",
+	CategoryEditing:    "Editing",
+	CategoryNumpad:     "Numpad",
+	CategoryMedia:      "Media",
+	CategoryModifier:   "Modifier",
+	CategoryLock:       "Lock",
+	CategorySymbol:     "Symbol",
+	CategoryControl:    "Control",
+	CategorySystem:     "System",
+}
+
+// String returns the category's name, such as "Letter" or "Numpad".
+func (c KeyCategory) String() string {
+	if int(c) < len(categoryNames) {
+		return categoryNames[c]
+	}
+	return categoryNames[CategoryUnknown]
+}
+
+// Category returns the key's category.
+func (k Key) Category() KeyCategory {
+	if int(k) < len(keyCategories) {
+		return keyCategories[k]
+	}
+	return CategoryUnknown
+}
+
+// IsModifier returns true if the key is one of the left or right Shift,
+// Ctrl, Alt or Super keys. Lock keys are not modifiers; see ModifierBit.
+func (k Key) IsModifier() bool {
+	return k.Category() == CategoryModifier
+}
+
+// ModifierBit returns the generic Modifier bit that the key drives:
+// ModShift for KeyShiftLeft and KeyShiftRight, ModCapsLock for KeyCapsLock,
+// and so on. It returns 0 for keys that drive no modifier. See
+// SideModifierBit for the side-specific bit.
+func (k Key) ModifierBit() Modifier {
+	switch k {
+	case KeyShiftLeft, KeyShiftRight:
+		return ModShift
+	case KeyCtrlLeft, KeyCtrlRight:
+		return ModCtrl
+	case KeyAltLeft, KeyAltRight:
+		return ModAlt
+	case KeySuperLeft, KeySuperRight:
+		return ModSuper
+	case KeyCapsLock:
+		return ModCapsLock
+	case KeyNumLock:
+		return ModNumLock
+	}
+	return 0
+}
+
+// SideModifierBit returns the side-specific Modifier bit that the key
+// drives, such as ModRightAlt for KeyAltRight, or 0 for keys that are not
+// one of the eight modifier keys.
+func (k Key) SideModifierBit() Modifier {
+	for _, s := range sideModifiers {
+		if s.key == k {
+			return s.side
+		}
+	}
+	return 0
+}
+
+// IsPrintable returns true if tapping the key on its own produces a visible
+// character or a space: letters, digits, symbols, Space and the numeric
+// keypad's digits and operators. Dead keys, which only modify the next
+// character, are not printable.
+func (k Key) IsPrintable() bool {
+	switch k.Category() {
+	case CategoryLetter, CategoryDigit:
+		return true
+	case CategorySymbol:
+		return k != KeyDeadCircumflex && k != KeyDeadDiaeresis
+	case CategoryNumpad:
+		return k != KeyNumpadEnter
+	}
+	return k == KeySpace
+}
@@ -0,0 +1,77 @@
+package keytypes
+
+import "testing"
+
+func TestKeyInfo(t *testing.T) {
+	tests := []struct {
+		key       Key
+		category  KeyCategory
+		modifier  Modifier
+		side      Modifier
+		printable bool
+	}{
+		{KeyA, CategoryLetter, 0, 0, true},
+		{Key5, CategoryDigit, 0, 0, true},
+		{KeyF13, CategoryFunction, 0, 0, false},
+		{KeyHome, CategoryNavigation, 0, 0, false},
+		{KeySpace, CategoryEditing, 0, 0, true},
+		{KeyEnter, CategoryEditing, 0, 0, false},
+		{KeyNumpad5, CategoryNumpad, 0, 0, true},
+		{KeyNumpadPlus, CategoryNumpad, 0, 0, true},
+		{KeyNumpadEnter, CategoryNumpad, 0, 0, false},
+		{KeyMute, CategoryMedia, 0, 0, false},
+		{KeyShiftLeft, CategoryModifier, ModShift, ModLeftShift, false},
+		{KeyAltRight, CategoryModifier, ModAlt, ModRightAlt, false},
+		{KeySuperRight, CategoryModifier, ModSuper, ModRightSuper, false},
+		{KeyCapsLock, CategoryLock, ModCapsLock, 0, false},
+		{KeyNumLock, CategoryLock, ModNumLock, 0, false},
+		{KeyEqual, CategorySymbol, 0, 0, true},
+		{KeyPlus, CategorySymbol, 0, 0, true},
+		{KeyDeadCircumflex, CategorySymbol, 0, 0, false},
+		{KeyEscape, CategorySystem, 0, 0, false},
+		{KeyPrintScreen, CategorySystem, 0, 0, false},
+		{KeyNUL, CategoryControl, 0, 0, false},
+		{KeyUnknown, CategoryUnknown, 0, 0, false},
+		{Key(9999), CategoryUnknown, 0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := tt.key.Category(); got != tt.category {
+			t.Errorf("%v.Category() = %v, want %v", tt.key, got, tt.category)
+		}
+		if got := tt.key.IsModifier(); got != (tt.category == CategoryModifier) {
+			t.Errorf("%v.IsModifier() = %v", tt.key, got)
+		}
+		if got := tt.key.ModifierBit(); got != tt.modifier {
+			t.Errorf("%v.ModifierBit() = %v, want %v", tt.key, got, tt.modifier)
+		}
+		if got := tt.key.SideModifierBit(); got != tt.side {
+			t.Errorf("%v.SideModifierBit() = %v, want %v", tt.key, got, tt.side)
+		}
+		if got := tt.key.IsPrintable(); got != tt.printable {
+			t.Errorf("%v.IsPrintable() = %v, want %v", tt.key, got, tt.printable)
+		}
+	}
+}
+
+func TestKeyCategoriesComplete(t *testing.T) {
+	for _, k := range AllKeys() {
+		c := k.Category()
+		if c == CategoryUnknown {
+			t.Errorf("%v has no category", k)
+		}
+		if k.IsModifier() != (k.SideModifierBit() != 0) {
+			t.Errorf("%v: IsModifier = %v but SideModifierBit = %v", k, k.IsModifier(), k.SideModifierBit())
+		}
+		if side := k.SideModifierBit(); side != 0 && side.Generic() != k.ModifierBit() {
+			t.Errorf("%v: SideModifierBit %v does not fold to ModifierBit %v", k, side, k.ModifierBit())
+		}
+	}
+	for c := CategoryUnknown; c <= CategorySystem; c++ {
+		if c.String() == "" || (c != CategoryUnknown && c.String() == "Unknown") {
+			t.Errorf("KeyCategory(%d).String() = %q", c, c.String())
+		}
+	}
+	if got := KeyCategory(200).String(); got != "Unknown" {
+		t.Errorf("KeyCategory(200).String() = %q, want Unknown", got)
+	}
+}
@@ -0,0 +1,79 @@
+package keytypes
+
+import "slices"
+
+// Key represents a logical keyboard key identifier.
+//
+// The named keys are available as constants such as KeyA, KeyEnter and
+// KeyF13, generated from the native key table; see AllKeys. The keyboard
+// package re-exports Key and its constants. Each constant is named after
+// the key's canonical native name, so the keypad keys are KeyNumpad0 to
+// KeyNumpad9, KeyNumpadEnter and so on rather than KeyKP5-style names;
+// StringToKey still accepts "kp5" and the other keypad aliases.
+type Key uint16
+
+// KeyToString converts a Key to its canonical string name, or "Unknown" for
+// KeyUnknown and ids that are not in the key table.
+//
+// Names are served from a table generated from the native library, so the
+// call neither crosses into C nor allocates.
+func KeyToString(key Key) string {
+	if int(key) < len(keyNames) && keyNames[key] != "" {
+		return keyNames[key]
+	}
+	return "Unknown"
+}
+
+// keyByName maps each canonical name to its key, for the exact match that
+// StringToKey tries before folding case.
+var keyByName = func() map[string]Key {
+	m := make(map[string]Key, len(allKeys))
+	for _, k := range allKeys {
+		m[keyNames[k]] = k
+	}
+	return m
+}()
+
+// maxKeyNameLen bounds the names StringToKey folds on the stack; no key name
+// or alias comes close.
+const maxKeyNameLen = 64
+
+// StringToKey parses a key name string to a Key value.
+// Returns 0 (Key unknown) for unrecognized inputs.
+// The parsing is case-insensitive and accepts common aliases like "esc", "space".
+//
+// Common key names:
+//   - Letters: "A"-"Z"
+//   - Digits: "0"-"9"
+//   - Function keys: "F1"-"F20"
+//   - Navigation: "Up", "Down", "Left", "Right", "Home", "End", "PageUp", "PageDown"
+//   - Editing: "Backspace", "Delete", "Insert", "Tab", "Return", "Enter", "Space"
+//   - Modifiers: "Shift", "Control", "Alt", "Super", "Meta", "CapsLock", "NumLock"
+//   - Special: "Escape", "Esc", "PrintScreen", "ScrollLock", "Pause"
+//
+// Like KeyToString, it is answered from generated tables that mirror the
+// native parser, including its aliases, without crossing into C or
+// allocating.
+func StringToKey(name string) Key {
+	if k, ok := keyByName[name]; ok {
+		return k
+	}
+	if len(name) > maxKeyNameLen {
+		return KeyUnknown
+	}
+	var buf [maxKeyNameLen]byte
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		buf[i] = c
+	}
+	return keyLookup[string(buf[:len(name)])]
+}
+
+// AllKeys returns every key in the native key table, in id order, excluding
+// KeyUnknown.
+func AllKeys() []Key {
+	return slices.Clone(allKeys[:])
+}
@@ -0,0 +1,1396 @@
+// Code generated by genkeys from the native key table. DO NOT EDIT.
+
+package keytypes
+
+// Key constants matching the native key table. Each constant's canonical
+// name, as returned by KeyToString, is noted beside it.
+const (
+	KeyUnknown            Key = 0
+	KeyA                  Key = 1   // "A"
+	KeyB                  Key = 2   // "B"
+	KeyC                  Key = 3   // "C"
+	KeyD                  Key = 4   // "D"
+	KeyE                  Key = 5   // "E"
+	KeyF                  Key = 6   // "F"
+	KeyG                  Key = 7   // "G"
+	KeyH                  Key = 8   // "H"
+	KeyI                  Key = 9   // "I"
+	KeyJ                  Key = 10  // "J"
+	KeyK                  Key = 11  // "K"
+	KeyL                  Key = 12  // "L"
+	KeyM                  Key = 13  // "M"
+	KeyN                  Key = 14  // "N"
+	KeyO                  Key = 15  // "O"
+	KeyP                  Key = 16  // "P"
+	KeyQ                  Key = 17  // "Q"
+	KeyR                  Key = 18  // "R"
+	KeyS                  Key = 19  // "S"
+	KeyT                  Key = 20  // "T"
+	KeyU                  Key = 21  // "U"
+	KeyV                  Key = 22  // "V"
+	KeyW                  Key = 23  // "W"
+	KeyX                  Key = 24  // "X"
+	KeyY                  Key = 25  // "Y"
+	KeyZ                  Key = 26  // "Z"
+	Key0                  Key = 33  // "0"
+	Key1                  Key = 34  // "1"
+	Key2                  Key = 35  // "2"
+	Key3                  Key = 36  // "3"
+	Key4                  Key = 37  // "4"
+	Key5                  Key = 38  // "5"
+	Key6                  Key = 39  // "6"
+	Key7                  Key = 40  // "7"
+	Key8                  Key = 41  // "8"
+	Key9                  Key = 42  // "9"
+	KeyF1                 Key = 43  // "F1"
+	KeyF2                 Key = 44  // "F2"
+	KeyF3                 Key = 45  // "F3"
+	KeyF4                 Key = 46  // "F4"
+	KeyF5                 Key = 47  // "F5"
+	KeyF6                 Key = 48  // "F6"
+	KeyF7                 Key = 49  // "F7"
+	KeyF8                 Key = 50  // "F8"
+	KeyF9                 Key = 51  // "F9"
+	KeyF10                Key = 52  // "F10"
+	KeyF11                Key = 53  // "F11"
+	KeyF12                Key = 54  // "F12"
+	KeyF13                Key = 55  // "F13"
+	KeyF14                Key = 56  // "F14"
+	KeyF15                Key = 57  // "F15"
+	KeyF16                Key = 58  // "F16"
+	KeyF17                Key = 59  // "F17"
+	KeyF18                Key = 60  // "F18"
+	KeyF19                Key = 61  // "F19"
+	KeyF20                Key = 62  // "F20"
+	KeyEnter              Key = 63  // "Enter"
+	KeyEscape             Key = 64  // "Escape"
+	KeyBackspace          Key = 65  // "Backspace"
+	KeyTab                Key = 66  // "Tab"
+	KeySpace              Key = 67  // "Space"
+	KeyLeft               Key = 68  // "Left"
+	KeyRight              Key = 69  // "Right"
+	KeyUp                 Key = 70  // "Up"
+	KeyDown               Key = 71  // "Down"
+	KeyHome               Key = 72  // "Home"
+	KeyEnd                Key = 73  // "End"
+	KeyPageUp             Key = 74  // "PageUp"
+	KeyPageDown           Key = 75  // "PageDown"
+	KeyDelete             Key = 76  // "Delete"
+	KeyInsert             Key = 77  // "Insert"
+	KeyPrintScreen        Key = 78  // "PrintScreen"
+	KeyScrollLock         Key = 79  // "ScrollLock"
+	KeyPause              Key = 80  // "Pause"
+	KeyNumpadDivide       Key = 83  // "NumpadDivide"
+	KeyNumpadMultiply     Key = 84  // "NumpadMultiply"
+	KeyNumpadMinus        Key = 85  // "NumpadMinus"
+	KeyNumpadPlus         Key = 86  // "NumpadPlus"
+	KeyNumpadEnter        Key = 87  // "NumpadEnter"
+	KeyNumpadDecimal      Key = 88  // "NumpadDecimal"
+	KeyNumpad0            Key = 89  // "Numpad0"
+	KeyNumpad1            Key = 90  // "Numpad1"
+	KeyNumpad2            Key = 91  // "Numpad2"
+	KeyNumpad3            Key = 92  // "Numpad3"
+	KeyNumpad4            Key = 93  // "Numpad4"
+	KeyNumpad5            Key = 94  // "Numpad5"
+	KeyNumpad6            Key = 95  // "Numpad6"
+	KeyNumpad7            Key = 96  // "Numpad7"
+	KeyNumpad8            Key = 97  // "Numpad8"
+	KeyNumpad9            Key = 98  // "Numpad9"
+	KeyShiftLeft          Key = 99  // "ShiftLeft"
+	KeyShiftRight         Key = 100 // "ShiftRight"
+	KeyCtrlLeft           Key = 101 // "CtrlLeft"
+	KeyCtrlRight          Key = 102 // "CtrlRight"
+	KeyAltLeft            Key = 103 // "AltLeft"
+	KeyAltRight           Key = 104 // "AltRight"
+	KeySuperLeft          Key = 105 // "SuperLeft"
+	KeySuperRight         Key = 106 // "SuperRight"
+	KeyCapsLock           Key = 107 // "CapsLock"
+	KeyNumLock            Key = 108 // "NumLock"
+	KeyHelp               Key = 109 // "Help"
+	KeyMenu               Key = 110 // "Menu"
+	KeyPower              Key = 111 // "Power"
+	KeySleep              Key = 112 // "Sleep"
+	KeyWake               Key = 113 // "Wake"
+	KeyMute               Key = 114 // "Mute"
+	KeyVolumeDown         Key = 115 // "VolumeDown"
+	KeyVolumeUp           Key = 116 // "VolumeUp"
+	KeyMediaPlayPause     Key = 117 // "MediaPlayPause"
+	KeyMediaStop          Key = 118 // "MediaStop"
+	KeyMediaNext          Key = 119 // "MediaNext"
+	KeyMediaPrevious      Key = 120 // "MediaPrevious"
+	KeyBrightnessDown     Key = 121 // "BrightnessDown"
+	KeyBrightnessUp       Key = 122 // "BrightnessUp"
+	KeyEject              Key = 123 // "Eject"
+	KeyGrave              Key = 124 // "`"
+	KeyMinus              Key = 125 // "-"
+	KeyEqual              Key = 126 // "="
+	KeyLeftBracket        Key = 127 // "["
+	KeyRightBracket       Key = 128 // "]"
+	KeyBackslash          Key = 129 // "\\"
+	KeySemicolon          Key = 130 // ";"
+	KeyApostrophe         Key = 131 // "'"
+	KeyComma              Key = 132 // ","
+	KeyPeriod             Key = 133 // "."
+	KeySlash              Key = 134 // "/"
+	KeyAt                 Key = 135 // "At"
+	KeyHashtag            Key = 136 // "Hashtag"
+	KeyExclamation        Key = 137 // "Exclamation"
+	KeyDollar             Key = 138 // "Dollar"
+	KeyPercent            Key = 139 // "Percent"
+	KeyCaret              Key = 140 // "Caret"
+	KeyAmpersand          Key = 141 // "Ampersand"
+	KeyAsterisk           Key = 142 // "Asterisk"
+	KeyLeftParen          Key = 143 // "LeftParen"
+	KeyRightParen         Key = 144 // "RightParen"
+	KeyUnderscore         Key = 145 // "Underscore"
+	KeyPlus               Key = 146 // "Plus"
+	KeyColon              Key = 147 // "Colon"
+	KeyQuote              Key = 148 // "Quote"
+	KeyQuestionMark       Key = 149 // "QuestionMark"
+	KeyBar                Key = 150 // "Bar"
+	KeyLessThan           Key = 151 // "LessThan"
+	KeyGreaterThan        Key = 152 // "GreaterThan"
+	KeyNUL                Key = 160 // "NUL"
+	KeySOH                Key = 161 // "SOH"
+	KeySTX                Key = 162 // "STX"
+	KeyETX                Key = 163 // "ETX"
+	KeyEOT                Key = 164 // "EOT"
+	KeyENQ                Key = 165 // "ENQ"
+	KeyACK                Key = 166 // "ACK"
+	KeyBell               Key = 167 // "Bell"
+	KeyVT                 Key = 171 // "VT"
+	KeyFF                 Key = 172 // "FF"
+	KeySO                 Key = 174 // "SO"
+	KeySI                 Key = 175 // "SI"
+	KeyDLE                Key = 176 // "DLE"
+	KeyDC1                Key = 177 // "DC1"
+	KeyDC2                Key = 178 // "DC2"
+	KeyDC3                Key = 179 // "DC3"
+	KeyDC4                Key = 180 // "DC4"
+	KeyNAK                Key = 181 // "NAK"
+	KeySYN                Key = 182 // "SYN"
+	KeyETB                Key = 183 // "ETB"
+	KeyCAN                Key = 184 // "CAN"
+	KeyEM                 Key = 185 // "EM"
+	KeySUB                Key = 186 // "SUB"
+	KeyFS                 Key = 188 // "FS"
+	KeyGS                 Key = 189 // "GS"
+	KeyRS                 Key = 190 // "RS"
+	KeyUS                 Key = 191 // "US"
+	KeyNumpadEqual        Key = 192 // "NumpadEqual"
+	KeyDegree             Key = 193 // "Degree"
+	KeySterling           Key = 194 // "Sterling"
+	KeyMu                 Key = 195 // "Mu"
+	KeyPlusMinus          Key = 196 // "PlusMinus"
+	KeyDeadCircumflex     Key = 197 // "DeadCircumflex"
+	KeyDeadDiaeresis      Key = 198 // "DeadDiaeresis"
+	KeySection            Key = 199 // "Section"
+	KeyCancel             Key = 200 // "Cancel"
+	KeyRedo               Key = 201 // "Redo"
+	KeyUndo               Key = 202 // "Undo"
+	KeyFind               Key = 203 // "Find"
+	KeyHangul             Key = 204 // "Hangul"
+	KeyHangulHanja        Key = 205 // "HangulHanja"
+	KeyKatakana           Key = 206 // "Katakana"
+	KeyHiragana           Key = 207 // "Hiragana"
+	KeyHenkan             Key = 208 // "Henkan"
+	KeyMuhenkan           Key = 209 // "Muhenkan"
+	KeyOE                 Key = 210 // "OE"
+	KeyOESmall            Key = 211 // "oe"
+	KeySunProps           Key = 212 // "SunProps"
+	KeySunFront           Key = 213 // "SunFront"
+	KeyCopy               Key = 214 // "Copy"
+	KeyOpen               Key = 215 // "Open"
+	KeyPaste              Key = 216 // "Paste"
+	KeyCut                Key = 217 // "Cut"
+	KeyCalculator         Key = 218 // "Calculator"
+	KeyExplorer           Key = 219 // "Explorer"
+	KeyPhone              Key = 220 // "Phone"
+	KeyWebCam             Key = 221 // "WebCam"
+	KeyAudioRecord        Key = 222 // "AudioRecord"
+	KeyAudioRewind        Key = 223 // "AudioRewind"
+	KeyAudioPreset        Key = 224 // "AudioPreset"
+	KeyMessenger          Key = 225 // "Messenger"
+	KeySearch             Key = 226 // "Search"
+	KeyGo                 Key = 227 // "Go"
+	KeyFinance            Key = 228 // "Finance"
+	KeyGame               Key = 229 // "Game"
+	KeyShop               Key = 230 // "Shop"
+	KeyHomePage           Key = 231 // "HomePage"
+	KeyReload             Key = 232 // "Reload"
+	KeyClose              Key = 233 // "Close"
+	KeySend               Key = 234 // "Send"
+	KeyXfer               Key = 235 // "Xfer"
+	KeyLaunchA            Key = 236 // "LaunchA"
+	KeyLaunchB            Key = 237 // "LaunchB"
+	KeyLaunch1            Key = 238 // "Launch1"
+	KeyLaunch2            Key = 239 // "Launch2"
+	KeyLaunch3            Key = 240 // "Launch3"
+	KeyLaunch4            Key = 241 // "Launch4"
+	KeyLaunch5            Key = 242 // "Launch5"
+	KeyLaunch6            Key = 243 // "Launch6"
+	KeyLaunch7            Key = 244 // "Launch7"
+	KeyLaunch8            Key = 245 // "Launch8"
+	KeyLaunch9            Key = 246 // "Launch9"
+	KeyTouchpadToggle     Key = 247 // "TouchpadToggle"
+	KeyTouchpadOn         Key = 248 // "TouchpadOn"
+	KeyTouchpadOff        Key = 249 // "TouchpadOff"
+	KeyKbdLightOnOff      Key = 250 // "KbdLightOnOff"
+	KeyKbdBrightnessDown  Key = 251 // "KbdBrightnessDown"
+	KeyKbdBrightnessUp    Key = 252 // "KbdBrightnessUp"
+	KeyMail               Key = 253 // "Mail"
+	KeyMailForward        Key = 254 // "MailForward"
+	KeySave               Key = 255 // "Save"
+	KeyDocuments          Key = 256 // "Documents"
+	KeyBattery            Key = 257 // "Battery"
+	KeyBluetooth          Key = 258 // "Bluetooth"
+	KeyWLAN               Key = 259 // "WLAN"
+	KeyUWB                Key = 260 // "UWB"
+	KeyNextVMode          Key = 261 // "Next_VMode"
+	KeyPrevVMode          Key = 262 // "Prev_VMode"
+	KeyMonBrightnessCycle Key = 263 // "MonBrightnessCycle"
+	KeyBrightnessAuto     Key = 264 // "BrightnessAuto"
+	KeyDisplayOff         Key = 265 // "DisplayOff"
+	KeyWWAN               Key = 266 // "WWAN"
+	KeyRFKill             Key = 267 // "RFKill"
+)
+
+// allKeys lists every named key in id order.
+var allKeys = [...]Key{
+	KeyA,
+	KeyB,
+	KeyC,
+	KeyD,
+	KeyE,
+	KeyF,
+	KeyG,
+	KeyH,
+	KeyI,
+	KeyJ,
+	KeyK,
+	KeyL,
+	KeyM,
+	KeyN,
+	KeyO,
+	KeyP,
+	KeyQ,
+	KeyR,
+	KeyS,
+	KeyT,
+	KeyU,
+	KeyV,
+	KeyW,
+	KeyX,
+	KeyY,
+	KeyZ,
+	Key0,
+	Key1,
+	Key2,
+	Key3,
+	Key4,
+	Key5,
+	Key6,
+	Key7,
+	Key8,
+	Key9,
+	KeyF1,
+	KeyF2,
+	KeyF3,
+	KeyF4,
+	KeyF5,
+	KeyF6,
+	KeyF7,
+	KeyF8,
+	KeyF9,
+	KeyF10,
+	KeyF11,
+	KeyF12,
+	KeyF13,
+	KeyF14,
+	KeyF15,
+	KeyF16,
+	KeyF17,
+	KeyF18,
+	KeyF19,
+	KeyF20,
+	KeyEnter,
+	KeyEscape,
+	KeyBackspace,
+	KeyTab,
+	KeySpace,
+	KeyLeft,
+	KeyRight,
+	KeyUp,
+	KeyDown,
+	KeyHome,
+	KeyEnd,
+	KeyPageUp,
+	KeyPageDown,
+	KeyDelete,
+	KeyInsert,
+	KeyPrintScreen,
+	KeyScrollLock,
+	KeyPause,
+	KeyNumpadDivide,
+	KeyNumpadMultiply,
+	KeyNumpadMinus,
+	KeyNumpadPlus,
+	KeyNumpadEnter,
+	KeyNumpadDecimal,
+	KeyNumpad0,
+	KeyNumpad1,
+	KeyNumpad2,
+	KeyNumpad3,
+	KeyNumpad4,
+	KeyNumpad5,
+	KeyNumpad6,
+	KeyNumpad7,
+	KeyNumpad8,
+	KeyNumpad9,
+	KeyShiftLeft,
+	KeyShiftRight,
+	KeyCtrlLeft,
+	KeyCtrlRight,
+	KeyAltLeft,
+	KeyAltRight,
+	KeySuperLeft,
+	KeySuperRight,
+	KeyCapsLock,
+	KeyNumLock,
+	KeyHelp,
+	KeyMenu,
+	KeyPower,
+	KeySleep,
+	KeyWake,
+	KeyMute,
+	KeyVolumeDown,
+	KeyVolumeUp,
+	KeyMediaPlayPause,
+	KeyMediaStop,
+	KeyMediaNext,
+	KeyMediaPrevious,
+	KeyBrightnessDown,
+	KeyBrightnessUp,
+	KeyEject,
+	KeyGrave,
+	KeyMinus,
+	KeyEqual,
+	KeyLeftBracket,
+	KeyRightBracket,
+	KeyBackslash,
+	KeySemicolon,
+	KeyApostrophe,
+	KeyComma,
+	KeyPeriod,
+	KeySlash,
+	KeyAt,
+	KeyHashtag,
+	KeyExclamation,
+	KeyDollar,
+	KeyPercent,
+	KeyCaret,
+	KeyAmpersand,
+	KeyAsterisk,
+	KeyLeftParen,
+	KeyRightParen,
+	KeyUnderscore,
+	KeyPlus,
+	KeyColon,
+	KeyQuote,
+	KeyQuestionMark,
+	KeyBar,
+	KeyLessThan,
+	KeyGreaterThan,
+	KeyNUL,
+	KeySOH,
+	KeySTX,
+	KeyETX,
+	KeyEOT,
+	KeyENQ,
+	KeyACK,
+	KeyBell,
+	KeyVT,
+	KeyFF,
+	KeySO,
+	KeySI,
+	KeyDLE,
+	KeyDC1,
+	KeyDC2,
+	KeyDC3,
+	KeyDC4,
+	KeyNAK,
+	KeySYN,
+	KeyETB,
+	KeyCAN,
+	KeyEM,
+	KeySUB,
+	KeyFS,
+	KeyGS,
+	KeyRS,
+	KeyUS,
+	KeyNumpadEqual,
+	KeyDegree,
+	KeySterling,
+	KeyMu,
+	KeyPlusMinus,
+	KeyDeadCircumflex,
+	KeyDeadDiaeresis,
+	KeySection,
+	KeyCancel,
+	KeyRedo,
+	KeyUndo,
+	KeyFind,
+	KeyHangul,
+	KeyHangulHanja,
+	KeyKatakana,
+	KeyHiragana,
+	KeyHenkan,
+	KeyMuhenkan,
+	KeyOE,
+	KeyOESmall,
+	KeySunProps,
+	KeySunFront,
+	KeyCopy,
+	KeyOpen,
+	KeyPaste,
+	KeyCut,
+	KeyCalculator,
+	KeyExplorer,
+	KeyPhone,
+	KeyWebCam,
+	KeyAudioRecord,
+	KeyAudioRewind,
+	KeyAudioPreset,
+	KeyMessenger,
+	KeySearch,
+	KeyGo,
+	KeyFinance,
+	KeyGame,
+	KeyShop,
+	KeyHomePage,
+	KeyReload,
+	KeyClose,
+	KeySend,
+	KeyXfer,
+	KeyLaunchA,
+	KeyLaunchB,
+	KeyLaunch1,
+	KeyLaunch2,
+	KeyLaunch3,
+	KeyLaunch4,
+	KeyLaunch5,
+	KeyLaunch6,
+	KeyLaunch7,
+	KeyLaunch8,
+	KeyLaunch9,
+	KeyTouchpadToggle,
+	KeyTouchpadOn,
+	KeyTouchpadOff,
+	KeyKbdLightOnOff,
+	KeyKbdBrightnessDown,
+	KeyKbdBrightnessUp,
+	KeyMail,
+	KeyMailForward,
+	KeySave,
+	KeyDocuments,
+	KeyBattery,
+	KeyBluetooth,
+	KeyWLAN,
+	KeyUWB,
+	KeyNextVMode,
+	KeyPrevVMode,
+	KeyMonBrightnessCycle,
+	KeyBrightnessAuto,
+	KeyDisplayOff,
+	KeyWWAN,
+	KeyRFKill,
+}
+
+// keyNames holds the canonical name of every named key, indexed by key.
+var keyNames = [...]string{
+	KeyA:                  "A",
+	KeyB:                  "B",
+	KeyC:                  "C",
+	KeyD:                  "D",
+	KeyE:                  "E",
+	KeyF:                  "F",
+	KeyG:                  "G",
+	KeyH:                  "H",
+	KeyI:                  "I",
+	KeyJ:                  "J",
+	KeyK:                  "K",
+	KeyL:                  "L",
+	KeyM:                  "M",
+	KeyN:                  "N",
+	KeyO:                  "O",
+	KeyP:                  "P",
+	KeyQ:                  "Q",
+	KeyR:                  "R",
+	KeyS:                  "S",
+	KeyT:                  "T",
+	KeyU:                  "U",
+	KeyV:                  "V",
+	KeyW:                  "W",
+	KeyX:                  "X",
+	KeyY:                  "Y",
+	KeyZ:                  "Z",
+	Key0:                  "0",
+	Key1:                  "1",
+	Key2:                  "2",
+	Key3:                  "3",
+	Key4:                  "4",
+	Key5:                  "5",
+	Key6:                  "6",
+	Key7:                  "7",
+	Key8:                  "8",
+	Key9:                  "9",
+	KeyF1:                 "F1",
+	KeyF2:                 "F2",
+	KeyF3:                 "F3",
+	KeyF4:                 "F4",
+	KeyF5:                 "F5",
+	KeyF6:                 "F6",
+	KeyF7:                 "F7",
+	KeyF8:                 "F8",
+	KeyF9:                 "F9",
+	KeyF10:                "F10",
+	KeyF11:                "F11",
+	KeyF12:                "F12",
+	KeyF13:                "F13",
+	KeyF14:                "F14",
+	KeyF15:                "F15",
+	KeyF16:                "F16",
+	KeyF17:                "F17",
+	KeyF18:                "F18",
+	KeyF19:                "F19",
+	KeyF20:                "F20",
+	KeyEnter:              "Enter",
+	KeyEscape:             "Escape",
+	KeyBackspace:          "Backspace",
+	KeyTab:                "Tab",
+	KeySpace:              "Space",
+	KeyLeft:               "Left",
+	KeyRight:              "Right",
+	KeyUp:                 "Up",
+	KeyDown:               "Down",
+	KeyHome:               "Home",
+	KeyEnd:                "End",
+	KeyPageUp:             "PageUp",
+	KeyPageDown:           "PageDown",
+	KeyDelete:             "Delete",
+	KeyInsert:             "Insert",
+	KeyPrintScreen:        "PrintScreen",
+	KeyScrollLock:         "ScrollLock",
+	KeyPause:              "Pause",
+	KeyNumpadDivide:       "NumpadDivide",
+	KeyNumpadMultiply:     "NumpadMultiply",
+	KeyNumpadMinus:        "NumpadMinus",
+	KeyNumpadPlus:         "NumpadPlus",
+	KeyNumpadEnter:        "NumpadEnter",
+	KeyNumpadDecimal:      "NumpadDecimal",
+	KeyNumpad0:            "Numpad0",
+	KeyNumpad1:            "Numpad1",
+	KeyNumpad2:            "Numpad2",
+	KeyNumpad3:            "Numpad3",
+	KeyNumpad4:            "Numpad4",
+	KeyNumpad5:            "Numpad5",
+	KeyNumpad6:            "Numpad6",
+	KeyNumpad7:            "Numpad7",
+	KeyNumpad8:            "Numpad8",
+	KeyNumpad9:            "Numpad9",
+	KeyShiftLeft:          "ShiftLeft",
+	KeyShiftRight:         "ShiftRight",
+	KeyCtrlLeft:           "CtrlLeft",
+	KeyCtrlRight:          "CtrlRight",
+	KeyAltLeft:            "AltLeft",
+	KeyAltRight:           "AltRight",
+	KeySuperLeft:          "SuperLeft",
+	KeySuperRight:         "SuperRight",
+	KeyCapsLock:           "CapsLock",
+	KeyNumLock:            "NumLock",
+	KeyHelp:               "Help",
+	KeyMenu:               "Menu",
+	KeyPower:              "Power",
+	KeySleep:              "Sleep",
+	KeyWake:               "Wake",
+	KeyMute:               "Mute",
+	KeyVolumeDown:         "VolumeDown",
+	KeyVolumeUp:           "VolumeUp",
+	KeyMediaPlayPause:     "MediaPlayPause",
+	KeyMediaStop:          "MediaStop",
+	KeyMediaNext:          "MediaNext",
+	KeyMediaPrevious:      "MediaPrevious",
+	KeyBrightnessDown:     "BrightnessDown",
+	KeyBrightnessUp:       "BrightnessUp",
+	KeyEject:              "Eject",
+	KeyGrave:              "`",
+	KeyMinus:              "-",
+	KeyEqual:              "=",
+	KeyLeftBracket:        "[",
+	KeyRightBracket:       "]",
+	KeyBackslash:          "\\",
+	KeySemicolon:          ";",
+	KeyApostrophe:         "'",
+	KeyComma:              ",",
+	KeyPeriod:             ".",
+	KeySlash:              "/",
+	KeyAt:                 "At",
+	KeyHashtag:            "Hashtag",
+	KeyExclamation:        "Exclamation",
+	KeyDollar:             "Dollar",
+	KeyPercent:            "Percent",
+	KeyCaret:              "Caret",
+	KeyAmpersand:          "Ampersand",
+	KeyAsterisk:           "Asterisk",
+	KeyLeftParen:          "LeftParen",
+	KeyRightParen:         "RightParen",
+	KeyUnderscore:         "Underscore",
+	KeyPlus:               "Plus",
+	KeyColon:              "Colon",
+	KeyQuote:              "Quote",
+	KeyQuestionMark:       "QuestionMark",
+	KeyBar:                "Bar",
+	KeyLessThan:           "LessThan",
+	KeyGreaterThan:        "GreaterThan",
+	KeyNUL:                "NUL",
+	KeySOH:                "SOH",
+	KeySTX:                "STX",
+	KeyETX:                "ETX",
+	KeyEOT:                "EOT",
+	KeyENQ:                "ENQ",
+	KeyACK:                "ACK",
+	KeyBell:               "Bell",
+	KeyVT:                 "VT",
+	KeyFF:                 "FF",
+	KeySO:                 "SO",
+	KeySI:                 "SI",
+	KeyDLE:                "DLE",
+	KeyDC1:                "DC1",
+	KeyDC2:                "DC2",
+	KeyDC3:                "DC3",
+	KeyDC4:                "DC4",
+	KeyNAK:                "NAK",
+	KeySYN:                "SYN",
+	KeyETB:                "ETB",
+	KeyCAN:                "CAN",
+	KeyEM:                 "EM",
+	KeySUB:                "SUB",
+	KeyFS:                 "FS",
+	KeyGS:                 "GS",
+	KeyRS:                 "RS",
+	KeyUS:                 "US",
+	KeyNumpadEqual:        "NumpadEqual",
+	KeyDegree:             "Degree",
+	KeySterling:           "Sterling",
+	KeyMu:                 "Mu",
+	KeyPlusMinus:          "PlusMinus",
+	KeyDeadCircumflex:     "DeadCircumflex",
+	KeyDeadDiaeresis:      "DeadDiaeresis",
+	KeySection:            "Section",
+	KeyCancel:             "Cancel",
+	KeyRedo:               "Redo",
+	KeyUndo:               "Undo",
+	KeyFind:               "Find",
+	KeyHangul:             "Hangul",
+	KeyHangulHanja:        "HangulHanja",
+	KeyKatakana:           "Katakana",
+	KeyHiragana:           "Hiragana",
+	KeyHenkan:             "Henkan",
+	KeyMuhenkan:           "Muhenkan",
+	KeyOE:                 "OE",
+	KeyOESmall:            "oe",
+	KeySunProps:           "SunProps",
+	KeySunFront:           "SunFront",
+	KeyCopy:               "Copy",
+	KeyOpen:               "Open",
+	KeyPaste:              "Paste",
+	KeyCut:                "Cut",
+	KeyCalculator:         "Calculator",
+	KeyExplorer:           "Explorer",
+	KeyPhone:              "Phone",
+	KeyWebCam:             "WebCam",
+	KeyAudioRecord:        "AudioRecord",
+	KeyAudioRewind:        "AudioRewind",
+	KeyAudioPreset:        "AudioPreset",
+	KeyMessenger:          "Messenger",
+	KeySearch:             "Search",
+	KeyGo:                 "Go",
+	KeyFinance:            "Finance",
+	KeyGame:               "Game",
+	KeyShop:               "Shop",
+	KeyHomePage:           "HomePage",
+	KeyReload:             "Reload",
+	KeyClose:              "Close",
+	KeySend:               "Send",
+	KeyXfer:               "Xfer",
+	KeyLaunchA:            "LaunchA",
+	KeyLaunchB:            "LaunchB",
+	KeyLaunch1:            "Launch1",
+	KeyLaunch2:            "Launch2",
+	KeyLaunch3:            "Launch3",
+	KeyLaunch4:            "Launch4",
+	KeyLaunch5:            "Launch5",
+	KeyLaunch6:            "Launch6",
+	KeyLaunch7:            "Launch7",
+	KeyLaunch8:            "Launch8",
+	KeyLaunch9:            "Launch9",
+	KeyTouchpadToggle:     "TouchpadToggle",
+	KeyTouchpadOn:         "TouchpadOn",
+	KeyTouchpadOff:        "TouchpadOff",
+	KeyKbdLightOnOff:      "KbdLightOnOff",
+	KeyKbdBrightnessDown:  "KbdBrightnessDown",
+	KeyKbdBrightnessUp:    "KbdBrightnessUp",
+	KeyMail:               "Mail",
+	KeyMailForward:        "MailForward",
+	KeySave:               "Save",
+	KeyDocuments:          "Documents",
+	KeyBattery:            "Battery",
+	KeyBluetooth:          "Bluetooth",
+	KeyWLAN:               "WLAN",
+	KeyUWB:                "UWB",
+	KeyNextVMode:          "Next_VMode",
+	KeyPrevVMode:          "Prev_VMode",
+	KeyMonBrightnessCycle: "MonBrightnessCycle",
+	KeyBrightnessAuto:     "BrightnessAuto",
+	KeyDisplayOff:         "DisplayOff",
+	KeyWWAN:               "WWAN",
+	KeyRFKill:             "RFKill",
+}
+
+// keyCategories holds the category of every named key, indexed by key.
+var keyCategories = [...]KeyCategory{
+	KeyA:                  CategoryLetter,
+	KeyB:                  CategoryLetter,
+	KeyC:                  CategoryLetter,
+	KeyD:                  CategoryLetter,
+	KeyE:                  CategoryLetter,
+	KeyF:                  CategoryLetter,
+	KeyG:                  CategoryLetter,
+	KeyH:                  CategoryLetter,
+	KeyI:                  CategoryLetter,
+	KeyJ:                  CategoryLetter,
+	KeyK:                  CategoryLetter,
+	KeyL:                  CategoryLetter,
+	KeyM:                  CategoryLetter,
+	KeyN:                  CategoryLetter,
+	KeyO:                  CategoryLetter,
+	KeyP:                  CategoryLetter,
+	KeyQ:                  CategoryLetter,
+	KeyR:                  CategoryLetter,
+	KeyS:                  CategoryLetter,
+	KeyT:                  CategoryLetter,
+	KeyU:                  CategoryLetter,
+	KeyV:                  CategoryLetter,
+	KeyW:                  CategoryLetter,
+	KeyX:                  CategoryLetter,
+	KeyY:                  CategoryLetter,
+	KeyZ:                  CategoryLetter,
+	Key0:                  CategoryDigit,
+	Key1:                  CategoryDigit,
+	Key2:                  CategoryDigit,
+	Key3:                  CategoryDigit,
+	Key4:                  CategoryDigit,
+	Key5:                  CategoryDigit,
+	Key6:                  CategoryDigit,
+	Key7:                  CategoryDigit,
+	Key8:                  CategoryDigit,
+	Key9:                  CategoryDigit,
+	KeyF1:                 CategoryFunction,
+	KeyF2:                 CategoryFunction,
+	KeyF3:                 CategoryFunction,
+	KeyF4:                 CategoryFunction,
+	KeyF5:                 CategoryFunction,
+	KeyF6:                 CategoryFunction,
+	KeyF7:                 CategoryFunction,
+	KeyF8:                 CategoryFunction,
+	KeyF9:                 CategoryFunction,
+	KeyF10:                CategoryFunction,
+	KeyF11:                CategoryFunction,
+	KeyF12:                CategoryFunction,
+	KeyF13:                CategoryFunction,
+	KeyF14:                CategoryFunction,
+	KeyF15:                CategoryFunction,
+	KeyF16:                CategoryFunction,
+	KeyF17:                CategoryFunction,
+	KeyF18:                CategoryFunction,
+	KeyF19:                CategoryFunction,
+	KeyF20:                CategoryFunction,
+	KeyEnter:              CategoryEditing,
+	KeyEscape:             CategorySystem,
+	KeyBackspace:          CategoryEditing,
+	KeyTab:                CategoryEditing,
+	KeySpace:              CategoryEditing,
+	KeyLeft:               CategoryNavigation,
+	KeyRight:              CategoryNavigation,
+	KeyUp:                 CategoryNavigation,
+	KeyDown:               CategoryNavigation,
+	KeyHome:               CategoryNavigation,
+	KeyEnd:                CategoryNavigation,
+	KeyPageUp:             CategoryNavigation,
+	KeyPageDown:           CategoryNavigation,
+	KeyDelete:             CategoryEditing,
+	KeyInsert:             CategoryEditing,
+	KeyPrintScreen:        CategorySystem,
+	KeyScrollLock:         CategoryLock,
+	KeyPause:              CategorySystem,
+	KeyNumpadDivide:       CategoryNumpad,
+	KeyNumpadMultiply:     CategoryNumpad,
+	KeyNumpadMinus:        CategoryNumpad,
+	KeyNumpadPlus:         CategoryNumpad,
+	KeyNumpadEnter:        CategoryNumpad,
+	KeyNumpadDecimal:      CategoryNumpad,
+	KeyNumpad0:            CategoryNumpad,
+	KeyNumpad1:            CategoryNumpad,
+	KeyNumpad2:            CategoryNumpad,
+	KeyNumpad3:            CategoryNumpad,
+	KeyNumpad4:            CategoryNumpad,
+	KeyNumpad5:            CategoryNumpad,
+	KeyNumpad6:            CategoryNumpad,
+	KeyNumpad7:            CategoryNumpad,
+	KeyNumpad8:            CategoryNumpad,
+	KeyNumpad9:            CategoryNumpad,
+	KeyShiftLeft:          CategoryModifier,
+	KeyShiftRight:         CategoryModifier,
+	KeyCtrlLeft:           CategoryModifier,
+	KeyCtrlRight:          CategoryModifier,
+	KeyAltLeft:            CategoryModifier,
+	KeyAltRight:           CategoryModifier,
+	KeySuperLeft:          CategoryModifier,
+	KeySuperRight:         CategoryModifier,
+	KeyCapsLock:           CategoryLock,
+	KeyNumLock:            CategoryLock,
+	KeyHelp:               CategorySystem,
+	KeyMenu:               CategorySystem,
+	KeyPower:              CategorySystem,
+	KeySleep:              CategorySystem,
+	KeyWake:               CategorySystem,
+	KeyMute:               CategoryMedia,
+	KeyVolumeDown:         CategoryMedia,
+	KeyVolumeUp:           CategoryMedia,
+	KeyMediaPlayPause:     CategoryMedia,
+	KeyMediaStop:          CategoryMedia,
+	KeyMediaNext:          CategoryMedia,
+	KeyMediaPrevious:      CategoryMedia,
+	KeyBrightnessDown:     CategorySystem,
+	KeyBrightnessUp:       CategorySystem,
+	KeyEject:              CategoryMedia,
+	KeyGrave:              CategorySymbol,
+	KeyMinus:              CategorySymbol,
+	KeyEqual:              CategorySymbol,
+	KeyLeftBracket:        CategorySymbol,
+	KeyRightBracket:       CategorySymbol,
+	KeyBackslash:          CategorySymbol,
+	KeySemicolon:          CategorySymbol,
+	KeyApostrophe:         CategorySymbol,
+	KeyComma:              CategorySymbol,
+	KeyPeriod:             CategorySymbol,
+	KeySlash:              CategorySymbol,
+	KeyAt:                 CategorySymbol,
+	KeyHashtag:            CategorySymbol,
+	KeyExclamation:        CategorySymbol,
+	KeyDollar:             CategorySymbol,
+	KeyPercent:            CategorySymbol,
+	KeyCaret:              CategorySymbol,
+	KeyAmpersand:          CategorySymbol,
+	KeyAsterisk:           CategorySymbol,
+	KeyLeftParen:          CategorySymbol,
+	KeyRightParen:         CategorySymbol,
+	KeyUnderscore:         CategorySymbol,
+	KeyPlus:               CategorySymbol,
+	KeyColon:              CategorySymbol,
+	KeyQuote:              CategorySymbol,
+	KeyQuestionMark:       CategorySymbol,
+	KeyBar:                CategorySymbol,
+	KeyLessThan:           CategorySymbol,
+	KeyGreaterThan:        CategorySymbol,
+	KeyNUL:                CategoryControl,
+	KeySOH:                CategoryControl,
+	KeySTX:                CategoryControl,
+	KeyETX:                CategoryControl,
+	KeyEOT:                CategoryControl,
+	KeyENQ:                CategoryControl,
+	KeyACK:                CategoryControl,
+	KeyBell:               CategoryControl,
+	KeyVT:                 CategoryControl,
+	KeyFF:                 CategoryControl,
+	KeySO:                 CategoryControl,
+	KeySI:                 CategoryControl,
+	KeyDLE:                CategoryControl,
+	KeyDC1:                CategoryControl,
+	KeyDC2:                CategoryControl,
+	KeyDC3:                CategoryControl,
+	KeyDC4:                CategoryControl,
+	KeyNAK:                CategoryControl,
+	KeySYN:                CategoryControl,
+	KeyETB:                CategoryControl,
+	KeyCAN:                CategoryControl,
+	KeyEM:                 CategoryControl,
+	KeySUB:                CategoryControl,
+	KeyFS:                 CategoryControl,
+	KeyGS:                 CategoryControl,
+	KeyRS:                 CategoryControl,
+	KeyUS:                 CategoryControl,
+	KeyNumpadEqual:        CategoryNumpad,
+	KeyDegree:             CategorySymbol,
+	KeySterling:           CategorySymbol,
+	KeyMu:                 CategorySymbol,
+	KeyPlusMinus:          CategorySymbol,
+	KeyDeadCircumflex:     CategorySymbol,
+	KeyDeadDiaeresis:      CategorySymbol,
+	KeySection:            CategorySymbol,
+	KeyCancel:             CategoryEditing,
+	KeyRedo:               CategoryEditing,
+	KeyUndo:               CategoryEditing,
+	KeyFind:               CategoryEditing,
+	KeyHangul:             CategorySystem,
+	KeyHangulHanja:        CategorySystem,
+	KeyKatakana:           CategorySystem,
+	KeyHiragana:           CategorySystem,
+	KeyHenkan:             CategorySystem,
+	KeyMuhenkan:           CategorySystem,
+	KeyOE:                 CategorySymbol,
+	KeyOESmall:            CategorySymbol,
+	KeySunProps:           CategorySystem,
+	KeySunFront:           CategorySystem,
+	KeyCopy:               CategoryEditing,
+	KeyOpen:               CategorySystem,
+	KeyPaste:              CategoryEditing,
+	KeyCut:                CategoryEditing,
+	KeyCalculator:         CategorySystem,
+	KeyExplorer:           CategorySystem,
+	KeyPhone:              CategorySystem,
+	KeyWebCam:             CategorySystem,
+	KeyAudioRecord:        CategoryMedia,
+	KeyAudioRewind:        CategoryMedia,
+	KeyAudioPreset:        CategoryMedia,
+	KeyMessenger:          CategorySystem,
+	KeySearch:             CategorySystem,
+	KeyGo:                 CategorySystem,
+	KeyFinance:            CategorySystem,
+	KeyGame:               CategorySystem,
+	KeyShop:               CategorySystem,
+	KeyHomePage:           CategorySystem,
+	KeyReload:             CategorySystem,
+	KeyClose:              CategorySystem,
+	KeySend:               CategorySystem,
+	KeyXfer:               CategorySystem,
+	KeyLaunchA:            CategorySystem,
+	KeyLaunchB:            CategorySystem,
+	KeyLaunch1:            CategorySystem,
+	KeyLaunch2:            CategorySystem,
+	KeyLaunch3:            CategorySystem,
+	KeyLaunch4:            CategorySystem,
+	KeyLaunch5:            CategorySystem,
+	KeyLaunch6:            CategorySystem,
+	KeyLaunch7:            CategorySystem,
+	KeyLaunch8:            CategorySystem,
+	KeyLaunch9:            CategorySystem,
+	KeyTouchpadToggle:     CategorySystem,
+	KeyTouchpadOn:         CategorySystem,
+	KeyTouchpadOff:        CategorySystem,
+	KeyKbdLightOnOff:      CategorySystem,
+	KeyKbdBrightnessDown:  CategorySystem,
+	KeyKbdBrightnessUp:    CategorySystem,
+	KeyMail:               CategorySystem,
+	KeyMailForward:        CategorySystem,
+	KeySave:               CategorySystem,
+	KeyDocuments:          CategorySystem,
+	KeyBattery:            CategorySystem,
+	KeyBluetooth:          CategorySystem,
+	KeyWLAN:               CategorySystem,
+	KeyUWB:                CategorySystem,
+	KeyNextVMode:          CategorySystem,
+	KeyPrevVMode:          CategorySystem,
+	KeyMonBrightnessCycle: CategorySystem,
+	KeyBrightnessAuto:     CategorySystem,
+	KeyDisplayOff:         CategorySystem,
+	KeyWWAN:               CategorySystem,
+	KeyRFKill:             CategorySystem,
+}
+
+// keyLookup maps lower-case key names and aliases to the key the native
+// parser resolves them to when matching case-insensitively.
+var keyLookup = map[string]Key{
+	" ":                  KeySpace,
+	"!":                  KeyExclamation,
+	"\"":                 KeyApostrophe,
+	"$":                  KeyDollar,
+	"&":                  KeyAmpersand,
+	"'":                  KeyApostrophe,
+	"(":                  KeyLeftParen,
+	")":                  KeyRightParen,
+	"*":                  KeyAsterisk,
+	"+":                  KeyEqual,
+	",":                  KeyComma,
+	"-":                  KeyMinus,
+	".":                  KeyPeriod,
+	"/":                  KeySlash,
+	"0":                  Key0,
+	"1":                  Key1,
+	"2":                  Key2,
+	"3":                  Key3,
+	"4":                  Key4,
+	"5":                  Key5,
+	"6":                  Key6,
+	"7":                  Key7,
+	"8":                  Key8,
+	"9":                  Key9,
+	":":                  KeySemicolon,
+	";":                  KeySemicolon,
+	"<":                  KeyComma,
+	"=":                  KeyEqual,
+	">":                  KeyPeriod,
+	"?":                  KeySlash,
+	"@":                  KeyAt,
+	"[":                  KeyLeftBracket,
+	"\\":                 KeyBackslash,
+	"]":                  KeyRightBracket,
+	"^":                  KeyCaret,
+	"_":                  KeyMinus,
+	"`":                  KeyGrave,
+	"a":                  KeyA,
+	"ack":                KeyACK,
+	"agrave":             KeyA,
+	"alt":                KeyAltLeft,
+	"alt_l":              KeyAltLeft,
+	"alt_r":              KeyAltRight,
+	"altleft":            KeyAltLeft,
+	"altright":           KeyAltRight,
+	"ampersand":          KeyAmpersand,
+	"apostrophe":         KeyApostrophe,
+	"asterisk":           KeyAsterisk,
+	"at":                 KeyAt,
+	"audiopreset":        KeyAudioPreset,
+	"audiorecord":        KeyAudioRecord,
+	"audiorewind":        KeyAudioRewind,
+	"b":                  KeyB,
+	"backslash":          KeyBackslash,
+	"backspace":          KeyBackspace,
+	"bang":               KeyExclamation,
+	"bar":                KeyBar,
+	"battery":            KeyBattery,
+	"bell":               KeyBell,
+	"bluetooth":          KeyBluetooth,
+	"bracketleft":        KeyLeftBracket,
+	"bracketright":       KeyRightBracket,
+	"break":              KeyPause,
+	"brightnessauto":     KeyBrightnessAuto,
+	"brightnessdown":     KeyBrightnessDown,
+	"brightnessup":       KeyBrightnessUp,
+	"c":                  KeyC,
+	"calculator":         KeyCalculator,
+	"can":                KeyCAN,
+	"cancel":             KeyCancel,
+	"caps_lock":          KeyCapsLock,
+	"capslock":           KeyCapsLock,
+	"caret":              KeyCaret,
+	"ccedilla":           KeyC,
+	"close":              KeyClose,
+	"colon":              KeyColon,
+	"comma":              KeyComma,
+	"control":            KeyCtrlLeft,
+	"control_l":          KeyCtrlLeft,
+	"control_r":          KeyCtrlRight,
+	"copy":               KeyCopy,
+	"ctrl":               KeyCtrlLeft,
+	"ctrlleft":           KeyCtrlLeft,
+	"ctrlright":          KeyCtrlRight,
+	"cut":                KeyCut,
+	"d":                  KeyD,
+	"dash":               KeyMinus,
+	"dc1":                KeyDC1,
+	"dc2":                KeyDC2,
+	"dc3":                KeyDC3,
+	"dc4":                KeyDC4,
+	"dead_circumflex":    KeyDeadCircumflex,
+	"dead_diaeresis":     KeyDeadDiaeresis,
+	"deadcircumflex":     KeyDeadCircumflex,
+	"deaddiaeresis":      KeyDeadDiaeresis,
+	"degree":             KeyDegree,
+	"del":                KeyDelete,
+	"delete":             KeyDelete,
+	"displayoff":         KeyDisplayOff,
+	"dle":                KeyDLE,
+	"documents":          KeyDocuments,
+	"dollar":             KeyDollar,
+	"dot":                KeyPeriod,
+	"down":               KeyDown,
+	"e":                  KeyE,
+	"eacute":             KeyE,
+	"egrave":             KeyE,
+	"eject":              KeyEject,
+	"em":                 KeyEM,
+	"end":                KeyEnd,
+	"enq":                KeyENQ,
+	"enter":              KeyEnter,
+	"eot":                KeyEOT,
+	"equal":              KeyEqual,
+	"esc":                KeyEscape,
+	"escape":             KeyEscape,
+	"etb":                KeyETB,
+	"etx":                KeyETX,
+	"exclam":             KeyExclamation,
+	"exclamation":        KeyExclamation,
+	"explorer":           KeyExplorer,
+	"f":                  KeyF,
+	"f1":                 KeyF1,
+	"f10":                KeyF10,
+	"f11":                KeyF11,
+	"f12":                KeyF12,
+	"f13":                KeyF13,
+	"f14":                KeyF14,
+	"f15":                KeyF15,
+	"f16":                KeyF16,
+	"f17":                KeyF17,
+	"f18":                KeyF18,
+	"f19":                KeyF19,
+	"f2":                 KeyF2,
+	"f20":                KeyF20,
+	"f3":                 KeyF3,
+	"f4":                 KeyF4,
+	"f5":                 KeyF5,
+	"f6":                 KeyF6,
+	"f7":                 KeyF7,
+	"f8":                 KeyF8,
+	"f9":                 KeyF9,
+	"ff":                 KeyFF,
+	"finance":            KeyFinance,
+	"find":               KeyFind,
+	"fs":                 KeyFS,
+	"g":                  KeyG,
+	"game":               KeyGame,
+	"go":                 KeyGo,
+	"grave":              KeyGrave,
+	"greater":            KeyGreaterThan,
+	"greaterthan":        KeyGreaterThan,
+	"gs":                 KeyGS,
+	"gt":                 KeyGreaterThan,
+	"h":                  KeyH,
+	"hangul":             KeyHangul,
+	"hangulhanja":        KeyHangulHanja,
+	"hash":               KeyHashtag,
+	"hashtag":            KeyHashtag,
+	"help":               KeyHelp,
+	"henkan":             KeyHenkan,
+	"hiragana":           KeyHiragana,
+	"home":               KeyHome,
+	"homepage":           KeyHomePage,
+	"hyper_l":            KeySuperLeft,
+	"hyphen":             KeyMinus,
+	"i":                  KeyI,
+	"insert":             KeyInsert,
+	"iso_left_tab":       KeyTab,
+	"iso_level3_shift":   KeyAltRight,
+	"iso_level5_shift":   KeyAltRight,
+	"j":                  KeyJ,
+	"k":                  KeyK,
+	"katakana":           KeyKatakana,
+	"kbdbrightnessdown":  KeyKbdBrightnessDown,
+	"kbdbrightnessup":    KeyKbdBrightnessUp,
+	"kbdlightonoff":      KeyKbdLightOnOff,
+	"kp0":                KeyNumpad0,
+	"kp1":                KeyNumpad1,
+	"kp2":                KeyNumpad2,
+	"kp3":                KeyNumpad3,
+	"kp4":                KeyNumpad4,
+	"kp5":                KeyNumpad5,
+	"kp6":                KeyNumpad6,
+	"kp7":                KeyNumpad7,
+	"kp8":                KeyNumpad8,
+	"kp9":                KeyNumpad9,
+	"kp_0":               KeyNumpad0,
+	"kp_1":               KeyNumpad1,
+	"kp_2":               KeyNumpad2,
+	"kp_3":               KeyNumpad3,
+	"kp_4":               KeyNumpad4,
+	"kp_5":               KeyNumpad5,
+	"kp_6":               KeyNumpad6,
+	"kp_7":               KeyNumpad7,
+	"kp_8":               KeyNumpad8,
+	"kp_9":               KeyNumpad9,
+	"kp_add":             KeyNumpadPlus,
+	"kp_decimal":         KeyNumpadDecimal,
+	"kp_divide":          KeyNumpadDivide,
+	"kp_enter":           KeyNumpadEnter,
+	"kp_equal":           KeyNumpadEqual,
+	"kp_minus":           KeyNumpadMinus,
+	"kp_multiply":        KeyNumpadMultiply,
+	"kp_plus":            KeyNumpadPlus,
+	"kp_subtract":        KeyNumpadMinus,
+	"kpdecimal":          KeyNumpadDecimal,
+	"kpdivide":           KeyNumpadDivide,
+	"kpenter":            KeyNumpadEnter,
+	"kpequal":            KeyNumpadEqual,
+	"kpminus":            KeyNumpadMinus,
+	"kpmultiply":         KeyNumpadMultiply,
+	"kpplus":             KeyNumpadPlus,
+	"l":                  KeyL,
+	"launch1":            KeyLaunch1,
+	"launch2":            KeyLaunch2,
+	"launch3":            KeyLaunch3,
+	"launch4":            KeyLaunch4,
+	"launch5":            KeyLaunch5,
+	"launch6":            KeyLaunch6,
+	"launch7":            KeyLaunch7,
+	"launch8":            KeyLaunch8,
+	"launch9":            KeyLaunch9,
+	"launcha":            KeyLaunchA,
+	"launchb":            KeyLaunchB,
+	"left":               KeyLeft,
+	"leftparen":          KeyLeftParen,
+	"less":               KeyLessThan,
+	"lessthan":           KeyLessThan,
+	"linefeed":           KeyEnter,
+	"lparen":             KeyLeftParen,
+	"lt":                 KeyLessThan,
+	"m":                  KeyM,
+	"mail":               KeyMail,
+	"mailforward":        KeyMailForward,
+	"medianext":          KeyMediaNext,
+	"mediaplaypause":     KeyMediaPlayPause,
+	"mediaprevious":      KeyMediaPrevious,
+	"mediastop":          KeyMediaStop,
+	"menu":               KeyMenu,
+	"messenger":          KeyMessenger,
+	"meta":               KeySuperLeft,
+	"meta_l":             KeySuperLeft,
+	"minus":              KeyMinus,
+	"monbrightnesscycle": KeyMonBrightnessCycle,
+	"mu":                 KeyMu,
+	"muhenkan":           KeyMuhenkan,
+	"mute":               KeyMute,
+	"n":                  KeyN,
+	"nak":                KeyNAK,
+	"next":               KeyPageDown,
+	"next_vmode":         KeyNextVMode,
+	"nul":                KeyNUL,
+	"num0":               Key0,
+	"num1":               Key1,
+	"num2":               Key2,
+	"num3":               Key3,
+	"num4":               Key4,
+	"num5":               Key5,
+	"num6":               Key6,
+	"num7":               Key7,
+	"num8":               Key8,
+	"num9":               Key9,
+	"num_lock":           KeyNumLock,
+	"numlock":            KeyNumLock,
+	"numpad0":            KeyNumpad0,
+	"numpad1":            KeyNumpad1,
+	"numpad2":            KeyNumpad2,
+	"numpad3":            KeyNumpad3,
+	"numpad4":            KeyNumpad4,
+	"numpad5":            KeyNumpad5,
+	"numpad6":            KeyNumpad6,
+	"numpad7":            KeyNumpad7,
+	"numpad8":            KeyNumpad8,
+	"numpad9":            KeyNumpad9,
+	"numpaddecimal":      KeyNumpadDecimal,
+	"numpaddivide":       KeyNumpadDivide,
+	"numpadenter":        KeyNumpadEnter,
+	"numpadequal":        KeyNumpadEqual,
+	"numpadminus":        KeyNumpadMinus,
+	"numpadmultiply":     KeyNumpadMultiply,
+	"numpadplus":         KeyNumpadPlus,
+	"o":                  KeyO,
+	"oe":                 KeyOESmall,
+	"open":               KeyOpen,
+	"p":                  KeyP,
+	"pagedown":           KeyPageDown,
+	"pageup":             KeyPageUp,
+	"parenleft":          KeyLeftParen,
+	"parenright":         KeyRightParen,
+	"paste":              KeyPaste,
+	"pause":              KeyPause,
+	"percent":            KeyPercent,
+	"period":             KeyPeriod,
+	"phone":              KeyPhone,
+	"pipe":               KeyBar,
+	"plus":               KeyPlus,
+	"plusminus":          KeyPlusMinus,
+	"pound":              KeyHashtag,
+	"power":              KeyPower,
+	"prev_vmode":         KeyPrevVMode,
+	"print":              KeyPrintScreen,
+	"printscreen":        KeyPrintScreen,
+	"prior":              KeyPageUp,
+	"q":                  KeyQ,
+	"question":           KeyQuestionMark,
+	"questionmark":       KeyQuestionMark,
+	"quote":              KeyQuote,
+	"quotedbl":           KeyQuote,
+	"r":                  KeyR,
+	"redo":               KeyRedo,
+	"reload":             KeyReload,
+	"return":             KeyEnter,
+	"rfkill":             KeyRFKill,
+	"right":              KeyRight,
+	"rightparen":         KeyRightParen,
+	"rparen":             KeyRightParen,
+	"rs":                 KeyRS,
+	"s":                  KeyS,
+	"save":               KeySave,
+	"scroll_lock":        KeyScrollLock,
+	"scrolllock":         KeyScrollLock,
+	"search":             KeySearch,
+	"section":            KeySection,
+	"semicolon":          KeySemicolon,
+	"send":               KeySend,
+	"shift":              KeyShiftLeft,
+	"shift_l":            KeyShiftLeft,
+	"shift_r":            KeyShiftRight,
+	"shiftleft":          KeyShiftLeft,
+	"shiftright":         KeyShiftRight,
+	"shop":               KeyShop,
+	"si":                 KeySI,
+	"slash":              KeySlash,
+	"sleep":              KeySleep,
+	"so":                 KeySO,
+	"soh":                KeySOH,
+	"space":              KeySpace,
+	"spacebar":           KeySpace,
+	"star":               KeyAsterisk,
+	"sterling":           KeySterling,
+	"stx":                KeySTX,
+	"sub":                KeySUB,
+	"sunfront":           KeySunFront,
+	"sunprops":           KeySunProps,
+	"super":              KeySuperLeft,
+	"super_l":            KeySuperLeft,
+	"super_r":            KeySuperRight,
+	"superleft":          KeySuperLeft,
+	"superright":         KeySuperRight,
+	"syn":                KeySYN,
+	"sys_req":            KeyPrintScreen,
+	"t":                  KeyT,
+	"tab":                KeyTab,
+	"touchpadoff":        KeyTouchpadOff,
+	"touchpadon":         KeyTouchpadOn,
+	"touchpadtoggle":     KeyTouchpadToggle,
+	"u":                  KeyU,
+	"ugrave":             KeyU,
+	"underscore":         KeyUnderscore,
+	"undo":               KeyUndo,
+	"up":                 KeyUp,
+	"us":                 KeyUS,
+	"uwb":                KeyUWB,
+	"v":                  KeyV,
+	"volumedown":         KeyVolumeDown,
+	"volumeup":           KeyVolumeUp,
+	"vt":                 KeyVT,
+	"w":                  KeyW,
+	"wake":               KeyWake,
+	"webcam":             KeyWebCam,
+	"win":                KeySuperLeft,
+	"wlan":               KeyWLAN,
+	"wwan":               KeyWWAN,
+	"x":                  KeyX,
+	"xfer":               KeyXfer,
+	"y":                  KeyY,
+	"z":                  KeyZ,
+	"{":                  KeyLeftBracket,
+	"|":                  KeyBackslash,
+	"}":                  KeyRightBracket,
+	"~":                  KeyGrave,
+}
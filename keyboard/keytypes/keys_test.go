@@ -0,0 +1,56 @@
+package keytypes
+
+import "testing"
+
+// benchNames mixes canonical names, other casings and aliases.
+var benchNames = []string{"A", "Enter", "escape", "ESC", "F13", "kp_5", "Space", "return"}
+
+func TestKeyNamesDoNotAllocate(t *testing.T) {
+	keys := AllKeys()
+	if n := testing.AllocsPerRun(100, func() {
+		for _, k := range keys {
+			_ = KeyToString(k)
+		}
+	}); n != 0 {
+		t.Errorf("KeyToString allocates %v times per run", n)
+	}
+	if n := testing.AllocsPerRun(100, func() {
+		for _, name := range benchNames {
+			_ = StringToKey(name)
+		}
+	}); n != 0 {
+		t.Errorf("StringToKey allocates %v times per run", n)
+	}
+}
+
+func TestKeyNamesRoundTrip(t *testing.T) {
+	for _, k := range AllKeys() {
+		name := KeyToString(k)
+		if got := StringToKey(name); got != k {
+			t.Errorf("StringToKey(%q) = %v, want %v", name, got, k)
+		}
+	}
+	for _, name := range benchNames {
+		if StringToKey(name) == KeyUnknown {
+			t.Errorf("StringToKey(%q) = KeyUnknown", name)
+		}
+	}
+	if got := KeyToString(KeyUnknown); got != "Unknown" {
+		t.Errorf("KeyToString(KeyUnknown) = %q, want Unknown", got)
+	}
+}
+
+func BenchmarkKeyToString(b *testing.B) {
+	keys := AllKeys()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = KeyToString(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkStringToKey(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = StringToKey(benchNames[i%len(benchNames)])
+	}
+}
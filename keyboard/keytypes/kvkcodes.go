@@ -0,0 +1,135 @@
+package keytypes
+
+// MacKeyCode returns the macOS virtual keycode (a kVK_* value from
+// HIToolbox/Events.h) for the key. The table is plain Go and works on every
+// GOOS, so bindings can be translated for macOS from any platform.
+//
+// Mac keyboards label the Insert position Help, so KeyInsert and KeyHelp
+// share kVK_Help, and KeyNumLock maps to kVK_ANSI_KeypadClear. It returns
+// false for keys with no keycode, such as the shifted symbols.
+func (k Key) MacKeyCode() (uint16, bool) {
+	return kvkTable.code(k)
+}
+
+// KeyFromMacKeyCode returns the key for a macOS virtual keycode, or
+// KeyUnknown and false if the code has no Key.
+func KeyFromMacKeyCode(code uint16) (Key, bool) {
+	return kvkTable.key(code)
+}
+
+var kvkTable = newCodeTable([]codePair[uint16]{
+	{KeyA, 0x00},
+	{KeyS, 0x01},
+	{KeyD, 0x02},
+	{KeyF, 0x03},
+	{KeyH, 0x04},
+	{KeyG, 0x05},
+	{KeyZ, 0x06},
+	{KeyX, 0x07},
+	{KeyC, 0x08},
+	{KeyV, 0x09},
+	{KeyB, 0x0B},
+	{KeyQ, 0x0C},
+	{KeyW, 0x0D},
+	{KeyE, 0x0E},
+	{KeyR, 0x0F},
+	{KeyY, 0x10},
+	{KeyT, 0x11},
+	{Key1, 0x12},
+	{Key2, 0x13},
+	{Key3, 0x14},
+	{Key4, 0x15},
+	{Key6, 0x16},
+	{Key5, 0x17},
+	{KeyEqual, 0x18},
+	{Key9, 0x19},
+	{Key7, 0x1A},
+	{KeyMinus, 0x1B},
+	{Key8, 0x1C},
+	{Key0, 0x1D},
+	{KeyRightBracket, 0x1E},
+	{KeyO, 0x1F},
+	{KeyU, 0x20},
+	{KeyLeftBracket, 0x21},
+	{KeyI, 0x22},
+	{KeyP, 0x23},
+	{KeyEnter, 0x24},
+	{KeyL, 0x25},
+	{KeyJ, 0x26},
+	{KeyApostrophe, 0x27},
+	{KeyK, 0x28},
+	{KeySemicolon, 0x29},
+	{KeyBackslash, 0x2A},
+	{KeyComma, 0x2B},
+	{KeySlash, 0x2C},
+	{KeyN, 0x2D},
+	{KeyM, 0x2E},
+	{KeyPeriod, 0x2F},
+	{KeyTab, 0x30},
+	{KeySpace, 0x31},
+	{KeyGrave, 0x32},
+	{KeyBackspace, 0x33},
+	{KeyEscape, 0x35},
+	{KeySuperRight, 0x36},
+	{KeySuperLeft, 0x37},
+	{KeyShiftLeft, 0x38},
+	{KeyCapsLock, 0x39},
+	{KeyAltLeft, 0x3A},
+	{KeyCtrlLeft, 0x3B},
+	{KeyShiftRight, 0x3C},
+	{KeyAltRight, 0x3D},
+	{KeyCtrlRight, 0x3E},
+	{KeyF17, 0x40},
+	{KeyNumpadDecimal, 0x41},
+	{KeyNumpadMultiply, 0x43},
+	{KeyNumpadPlus, 0x45},
+	{KeyNumLock, 0x47},
+	{KeyVolumeUp, 0x48},
+	{KeyVolumeDown, 0x49},
+	{KeyMute, 0x4A},
+	{KeyNumpadDivide, 0x4B},
+	{KeyNumpadEnter, 0x4C},
+	{KeyNumpadMinus, 0x4E},
+	{KeyF18, 0x4F},
+	{KeyF19, 0x50},
+	{KeyNumpadEqual, 0x51},
+	{KeyNumpad0, 0x52},
+	{KeyNumpad1, 0x53},
+	{KeyNumpad2, 0x54},
+	{KeyNumpad3, 0x55},
+	{KeyNumpad4, 0x56},
+	{KeyNumpad5, 0x57},
+	{KeyNumpad6, 0x58},
+	{KeyNumpad7, 0x59},
+	{KeyF20, 0x5A},
+	{KeyNumpad8, 0x5B},
+	{KeyNumpad9, 0x5C},
+	{KeyF5, 0x60},
+	{KeyF6, 0x61},
+	{KeyF7, 0x62},
+	{KeyF3, 0x63},
+	{KeyF8, 0x64},
+	{KeyF9, 0x65},
+	{KeyF11, 0x67},
+	{KeyF13, 0x69},
+	{KeyF16, 0x6A},
+	{KeyF14, 0x6B},
+	{KeyF10, 0x6D},
+	{KeyMenu, 0x6E},
+	{KeyF12, 0x6F},
+	{KeyF15, 0x71},
+	{KeyHelp, 0x72},
+	{KeyInsert, 0x72},
+	{KeyHome, 0x73},
+	{KeyPageUp, 0x74},
+	{KeyDelete, 0x75},
+	{KeyF4, 0x76},
+	{KeyEnd, 0x77},
+	{KeyF2, 0x78},
+	{KeyPageDown, 0x79},
+	{KeyF1, 0x7A},
+	{KeyLeft, 0x7B},
+	{KeyRight, 0x7C},
+	{KeyDown, 0x7D},
+	{KeyUp, 0x7E},
+})
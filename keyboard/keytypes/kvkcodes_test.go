@@ -0,0 +1,30 @@
+package keytypes
+
+import "testing"
+
+func TestMacKeyCodes(t *testing.T) {
+	// Values from HIToolbox/Events.h.
+	checkCodes(t, "macOS keycode", []codeCase{
+		{KeyA, 0x00},              // kVK_ANSI_A
+		{Key0, 0x1D},              // kVK_ANSI_0
+		{KeyEnter, 0x24},          // kVK_Return
+		{KeyTab, 0x30},            // kVK_Tab
+		{KeySpace, 0x31},          // kVK_Space
+		{KeyBackspace, 0x33},      // kVK_Delete
+		{KeyEscape, 0x35},         // kVK_Escape
+		{KeySuperLeft, 0x37},      // kVK_Command
+		{KeyShiftLeft, 0x38},      // kVK_Shift
+		{KeyCapsLock, 0x39},       // kVK_CapsLock
+		{KeyAltRight, 0x3D},       // kVK_RightOption
+		{KeyCtrlRight, 0x3E},      // kVK_RightControl
+		{KeyNumpadMultiply, 0x43}, // kVK_ANSI_KeypadMultiply
+		{KeyVolumeUp, 0x48},       // kVK_VolumeUp
+		{KeyMute, 0x4A},           // kVK_Mute
+		{KeyNumpad5, 0x57},        // kVK_ANSI_Keypad5
+		{KeyF13, 0x69},            // kVK_F13
+		{KeyDelete, 0x75},         // kVK_ForwardDelete
+		{KeyF1, 0x7A},             // kVK_F1
+		{KeyLeft, 0x7B},           // kVK_LeftArrow
+	}, Key.MacKeyCode, KeyFromMacKeyCode)
+	checkCodeTable(t, "macOS keycode", kvkTable, Key.MacKeyCode, KeyFromMacKeyCode)
+}
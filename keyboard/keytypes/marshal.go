@@ -0,0 +1,200 @@
+package keytypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// String returns the key's canonical name, such as "Enter", or "Key(N)" for
+// an id that is not in the key table.
+func (k Key) String() string {
+	if int(k) < len(keyNames) && keyNames[k] != "" {
+		return keyNames[k]
+	}
+	if k == KeyUnknown {
+		return "Unknown"
+	}
+	return "Key(" + strconv.Itoa(int(k)) + ")"
+}
+
+// MarshalText implements encoding.TextMarshaler. Keys are encoded by their
+// canonical name, so encoded values stay valid if key ids change. Ids that
+// are not in the key table cannot be encoded.
+func (k Key) MarshalText() ([]byte, error) {
+	if k != KeyUnknown && (int(k) >= len(keyNames) || keyNames[k] == "") {
+		return nil, fmt.Errorf("cannot marshal key id %d: not in the key table", uint16(k))
+	}
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any name
+// StringToKey does, case-insensitively and including aliases, and
+// "Unknown" for KeyUnknown.
+func (k *Key) UnmarshalText(text []byte) error {
+	name := string(text)
+	if strings.EqualFold(name, "Unknown") {
+		*k = KeyUnknown
+		return nil
+	}
+	key := StringToKey(name)
+	if key == KeyUnknown {
+		return fmt.Errorf("%w %q", ErrUnknownKey, name)
+	}
+	*k = key
+	return nil
+}
+
+// modifierOrder lists the modifier bits in the order String writes them.
+var modifierOrder = []struct {
+	mod  Modifier
+	name string
+}{
+	{ModCtrl, "Ctrl"},
+	{ModAlt, "Alt"},
+	{ModShift, "Shift"},
+	{ModSuper, "Super"},
+	{ModAltGr, "AltGr"},
+	{ModMeta, "Meta"},
+	{ModHyper, "Hyper"},
+	{ModCapsLock, "CapsLock"},
+	{ModNumLock, "NumLock"},
+	{ModLeftCtrl, "LeftCtrl"},
+	{ModRightCtrl, "RightCtrl"},
+	{ModLeftAlt, "LeftAlt"},
+	{ModRightAlt, "RightAlt"},
+	{ModLeftShift, "LeftShift"},
+	{ModRightShift, "RightShift"},
+	{ModLeftSuper, "LeftSuper"},
+	{ModRightSuper, "RightSuper"},
+}
+
+// String returns the set modifiers joined by '|', such as "Ctrl|Shift" or
+// "Alt|RightAlt", or "None" if no modifier is set. Bits with no name are
+// written in hex.
+func (m Modifier) String() string {
+	if m == 0 {
+		return "None"
+	}
+	var parts []string
+	rest := m
+	for _, o := range modifierOrder {
+		if m&o.mod != 0 {
+			parts = append(parts, o.name)
+			rest &^= o.mod
+		}
+	}
+	if rest != 0 {
+		parts = append(parts, fmt.Sprintf("0x%X", uint64(rest)))
+	}
+	return strings.Join(parts, "|")
+}
+
+// MarshalText implements encoding.TextMarshaler using the String form.
+func (m Modifier) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the String
+// form, with names matched case-insensitively and the aliases ParseChord
+// accepts (Control, Cmd, Option, ...). Empty text and "None" decode to 0.
+func (m *Modifier) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	var mods Modifier
+	if s == "" || strings.EqualFold(s, "None") {
+		*m = 0
+		return nil
+	}
+	for part := range strings.SplitSeq(s, "|") {
+		name := strings.ToLower(strings.TrimSpace(part))
+		if mod, ok := lookupModifier(name); ok {
+			mods |= mod
+			continue
+		}
+		if hex, ok := strings.CutPrefix(name, "0x"); ok {
+			if v, err := strconv.ParseUint(hex, 16, 64); err == nil && Modifier(v) != 0 && uint64(Modifier(v)) == v {
+				mods |= Modifier(v)
+				continue
+			}
+		}
+		return fmt.Errorf("unknown modifier %q in %q", strings.TrimSpace(part), s)
+	}
+	*m = mods
+	return nil
+}
+
+// lookupModifier resolves a lower-case modifier name.
+func lookupModifier(name string) (Modifier, bool) {
+	switch name {
+	case "capslock":
+		return ModCapsLock, true
+	case "numlock":
+		return ModNumLock, true
+	}
+	mod, ok := modifierNames[name]
+	return mod, ok
+}
+
+// keyEventJSON is the JSON form of KeyEvent. Field names are part of the
+// format and must not change.
+type keyEventJSON struct {
+	Key          Key       `json:"key"`
+	Codepoint    uint32    `json:"codepoint,omitempty"`
+	Modifiers    Modifier  `json:"modifiers"`
+	Sides        Modifier  `json:"sides,omitempty"`
+	Pressed      bool      `json:"pressed"`
+	Time         time.Time `json:"time,omitzero"`
+	Seq          uint64    `json:"seq,omitempty"`
+	HoldDuration string    `json:"hold_duration,omitempty"`
+}
+
+// MarshalJSON encodes the event with the key and modifiers by name and the
+// hold duration in time.Duration string form, omitting sides when none are
+// held, for example:
+//
+//	{"key":"A","codepoint":97,"modifiers":"None","pressed":false,
+//	 "time":"2025-01-02T15:04:05.123456789Z","seq":42,"hold_duration":"85ms"}
+func (e KeyEvent) MarshalJSON() ([]byte, error) {
+	j := keyEventJSON{
+		Key:       e.Key,
+		Codepoint: e.Codepoint,
+		Modifiers: e.Modifiers,
+		Sides:     e.Sides,
+		Pressed:   e.Pressed,
+		Time:      e.Time,
+		Seq:       e.Seq,
+	}
+	if e.HoldDuration != 0 {
+		j.HoldDuration = e.HoldDuration.String()
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes the form produced by MarshalJSON.
+func (e *KeyEvent) UnmarshalJSON(data []byte) error {
+	var j keyEventJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	var hold time.Duration
+	if j.HoldDuration != "" {
+		d, err := time.ParseDuration(j.HoldDuration)
+		if err != nil {
+			return fmt.Errorf("invalid hold_duration: %w", err)
+		}
+		hold = d
+	}
+	*e = KeyEvent{
+		Codepoint:    j.Codepoint,
+		Key:          j.Key,
+		Modifiers:    j.Modifiers,
+		Sides:        j.Sides,
+		Pressed:      j.Pressed,
+		Time:         j.Time,
+		Seq:          j.Seq,
+		HoldDuration: hold,
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package keytypes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKeyTextRoundTrip(t *testing.T) {
+	for _, k := range append(AllKeys(), KeyUnknown) {
+		text, err := k.MarshalText()
+		if err != nil {
+			t.Errorf("%v.MarshalText: %v", k, err)
+			continue
+		}
+		var got Key
+		if err := got.UnmarshalText(text); err != nil || got != k {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", text, got, err, k)
+		}
+	}
+	if _, err := Key(9999).MarshalText(); err == nil {
+		t.Error("Key(9999).MarshalText succeeded, want an error")
+	}
+}
+
+func TestKeyUnmarshalText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Key
+	}{
+		{"Enter", KeyEnter},
+		{"enter", KeyEnter},
+		{"Return", KeyEnter},
+		{"esc", KeyEscape},
+		{"unknown", KeyUnknown},
+	}
+	for _, tt := range tests {
+		var got Key
+		if err := got.UnmarshalText([]byte(tt.in)); err != nil || got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	k := KeyA
+	if err := k.UnmarshalText([]byte("NoSuchKey")); !errors.Is(err, ErrUnknownKey) || k != KeyA {
+		t.Errorf("UnmarshalText(NoSuchKey) = %v leaving %v, want ErrUnknownKey leaving A", err, k)
+	}
+}
+
+func TestModifierTextRoundTrip(t *testing.T) {
+	mods := []Modifier{
+		0,
+		ModCtrl,
+		ModCtrl | ModShift,
+		ModAlt | ModRightAlt,
+		ModShift | ModLeftShift | ModRightShift,
+		ModAltGr | ModMeta | ModHyper,
+		ModCapsLock | ModNumLock,
+		ModSuper | ModLeftSuper | ModHyper,
+		1 << 30,
+	}
+	for _, m := range mods {
+		text, err := m.MarshalText()
+		if err != nil {
+			t.Errorf("%v.MarshalText: %v", m, err)
+			continue
+		}
+		var got Modifier
+		if err := got.UnmarshalText(text); err != nil || got != m {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", text, got, err, m)
+		}
+	}
+}
+
+func TestModifierUnmarshalText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Modifier
+	}{
+		{"", 0},
+		{"none", 0},
+		{"control | cmd", ModCtrl | ModSuper},
+		{"Option|RAlt", ModAlt | ModRightAlt},
+		{"altgr|META|hyper", ModAltGr | ModMeta | ModHyper},
+		{"capslock", ModCapsLock},
+	}
+	for _, tt := range tests {
+		var got Modifier
+		if err := got.UnmarshalText([]byte(tt.in)); err != nil || got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"Ctrl|Nope", "0x0", "Ctrl|"} {
+		m := ModShift
+		if err := m.UnmarshalText([]byte(in)); err == nil || m != ModShift {
+			t.Errorf("UnmarshalText(%q) = %v leaving %v, want an error leaving Shift", in, err, m)
+		}
+	}
+}
+
+func TestKeyEventJSONRoundTrip(t *testing.T) {
+	events := []KeyEvent{
+		{Key: KeyA, Codepoint: 'a', Pressed: true, Seq: 1},
+		{
+			Key:          KeyE,
+			Codepoint:    'é',
+			Modifiers:    ModAltGr | ModShift,
+			Sides:        ModLeftShift | ModRightAlt,
+			Time:         time.Date(2025, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			Seq:          42,
+			HoldDuration: 85 * time.Millisecond,
+		},
+		{Key: KeyK, Modifiers: ModMeta | ModHyper | ModCtrl, Sides: ModRightCtrl, Pressed: true},
+		{},
+	}
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Errorf("Marshal(%+v): %v", e, err)
+			continue
+		}
+		var got KeyEvent
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Errorf("Unmarshal(%s): %v", data, err)
+			continue
+		}
+		if !got.Time.Equal(e.Time) {
+			t.Errorf("Unmarshal(%s).Time = %v, want %v", data, got.Time, e.Time)
+		}
+		got.Time = e.Time
+		if got != e {
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", data, got, e)
+		}
+	}
+}
+
+func TestKeyEventJSONForm(t *testing.T) {
+	e := KeyEvent{Key: KeyA, Modifiers: ModShift, Sides: ModLeftShift, Pressed: true, HoldDuration: time.Second}
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"key":"A","modifiers":"Shift","sides":"LeftShift","pressed":true,"hold_duration":"1s"}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	for _, in := range []string{
+		`{"key":"NoSuchKey","modifiers":"None","pressed":true}`,
+		`{"key":"A","modifiers":"Nope","pressed":true}`,
+		`{"key":"A","modifiers":"None","pressed":true,"hold_duration":"soon"}`,
+	} {
+		var got KeyEvent
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded, want an error", in)
+		}
+	}
+}
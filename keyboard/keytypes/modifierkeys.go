@@ -0,0 +1,92 @@
+package keytypes
+
+import "slices"
+
+// ModifierKeys assigns the keys that produce the extended modifiers, which
+// have no fixed key of their own. The zero value assigns none, so the
+// extended bits never appear in events and cannot be sent.
+//
+// Assignments are made per Listener and Sender with their SetModifierKeys
+// methods, for example AltGr to the right Alt key on ISO layouts, or Meta to
+// the left Alt key for Emacs-style bindings:
+//
+//	listener.SetModifierKeys(keyboard.ModifierKeys{AltGr: keyboard.KeyAltRight})
+type ModifierKeys struct {
+	// AltGr is the key that produces ModAltGr (ISO_Level3_Shift).
+	AltGr Key
+
+	// Meta is the key that produces ModMeta.
+	Meta Key
+
+	// Hyper is the key that produces ModHyper.
+	Hyper Key
+}
+
+// Key returns the key assigned to a single extended modifier, or KeyUnknown
+// if it has none or mod is not an extended modifier.
+func (mk ModifierKeys) Key(mod Modifier) Key {
+	switch mod {
+	case ModAltGr:
+		return mk.AltGr
+	case ModMeta:
+		return mk.Meta
+	case ModHyper:
+		return mk.Hyper
+	}
+	return KeyUnknown
+}
+
+// Bits returns the extended modifiers assigned to key.
+func (mk ModifierKeys) Bits(key Key) Modifier {
+	var bits Modifier
+	for bit := ModAltGr; bit <= ModHyper && key != KeyUnknown; bit <<= 1 {
+		if mk.Key(bit) == key {
+			bits |= bit
+		}
+	}
+	return bits
+}
+
+// Unassigned returns the extended modifiers in mods that have no key.
+func (mk ModifierKeys) Unassigned(mods Modifier) Modifier {
+	for bit := ModAltGr; bit <= ModHyper; bit <<= 1 {
+		if mk.Key(bit) != KeyUnknown {
+			mods &^= bit
+		}
+	}
+	return mods & ExtendedModifiers
+}
+
+// Keys is like Modifier.Keys, followed by the keys assigned to the extended
+// modifiers in mods.
+func (mk ModifierKeys) Keys(mods Modifier) []Key {
+	keys := mods.Keys()
+	for bit := ModAltGr; bit <= ModHyper; bit <<= 1 {
+		if key := mk.Key(bit); mods&bit != 0 && key != KeyUnknown && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Apply rewrites a modifier state so that each held modifier key assigned
+// to an extended modifier is reported as that modifier rather than as
+// itself. mods holds the generic bits and sides the side-specific bits of
+// the held modifier keys. For each assigned key in sides, its side-specific
+// bit is replaced by its extended bits in mods, and its generic bit is
+// dropped unless the key on the other side is held too, so that AltGr+E is
+// not also Alt+E.
+func (mk ModifierKeys) Apply(mods, sides Modifier) (Modifier, Modifier) {
+	for bit := ModAltGr; bit <= ModHyper; bit <<= 1 {
+		side := mk.Key(bit).SideModifierBit()
+		if side == 0 || sides&side == 0 {
+			continue
+		}
+		mods |= bit
+		sides &^= side
+		if sides&side.Generic().SideBits() == 0 {
+			mods &^= side.Generic()
+		}
+	}
+	return mods, sides
+}
@@ -0,0 +1,151 @@
+package keytypes
+
+// Modifier represents a bitmask of keyboard modifier keys.
+//
+// The low byte holds the generic bits defined by the C API (ModShift,
+// ModCtrl, ...), which are set whichever side's key is held. The
+// side-specific bits (ModLeftShift, ModRightCtrl, ...) additionally say which
+// key it was. Code that only checks the generic bits keeps working unchanged.
+type Modifier uint32
+
+// Modifier constants matching the C API's AXIDEV_IO_MOD_* values.
+// These can be combined using bitwise OR:
+//
+//	mods := keyboard.ModCtrl | keyboard.ModShift
+//	sender.Combo(mods, keyboard.KeyS)  // Ctrl+Shift+S
+const (
+	ModShift    Modifier = 0x01
+	ModCtrl     Modifier = 0x02
+	ModAlt      Modifier = 0x04
+	ModSuper    Modifier = 0x08
+	ModCapsLock Modifier = 0x10
+	ModNumLock  Modifier = 0x20
+)
+
+// Side-specific modifier bits. Listener events report them in
+// KeyEvent.Sides, separately from the generic bits, and HoldModifier,
+// ReleaseModifier and Combo accept them to press that side's key:
+//
+//	sender.Combo(keyboard.ModRightAlt, keyboard.KeyE) // AltGr+E
+const (
+	ModLeftShift Modifier = 1 << (8 + iota)
+	ModRightShift
+	ModLeftCtrl
+	ModRightCtrl
+	ModLeftAlt
+	ModRightAlt
+	ModLeftSuper
+	ModRightSuper
+)
+
+// Extended modifier bits for modifiers that have no key of their own. Each
+// is produced by the key assigned to it with SetModifierKeys on a Listener
+// or Sender; none is assigned by default. Listener events and
+// ActiveModifiers set the bit while its key is held, and HoldModifier,
+// ReleaseModifier and Combo press and release that key:
+//
+//	sender.SetModifierKeys(keyboard.ModifierKeys{AltGr: keyboard.KeyAltRight})
+//	sender.Combo(keyboard.ModAltGr, keyboard.KeyE) // € on many European layouts
+const (
+	ModAltGr Modifier = 1 << (16 + iota)
+	ModMeta
+	ModHyper
+)
+
+// Masks of the modifier bits by kind.
+const (
+	// NativeModifiers are the bits defined by the C API: the generic and
+	// lock bits.
+	NativeModifiers = ModShift | ModCtrl | ModAlt | ModSuper | ModCapsLock | ModNumLock
+
+	// LockModifiers are the bits toggled by lock keys rather than held.
+	LockModifiers = ModCapsLock | ModNumLock
+
+	// SideModifiers are the side-specific bits.
+	SideModifiers = ModLeftShift | ModRightShift | ModLeftCtrl | ModRightCtrl |
+		ModLeftAlt | ModRightAlt | ModLeftSuper | ModRightSuper
+
+	// ExtendedModifiers are the extended bits.
+	ExtendedModifiers = ModAltGr | ModMeta | ModHyper
+)
+
+// sideModifiers pairs each side-specific bit with its generic bit and key,
+// in the order modifier keys are pressed.
+var sideModifiers = []struct {
+	side    Modifier
+	generic Modifier
+	key     Key
+}{
+	{ModLeftCtrl, ModCtrl, KeyCtrlLeft},
+	{ModRightCtrl, ModCtrl, KeyCtrlRight},
+	{ModLeftAlt, ModAlt, KeyAltLeft},
+	{ModRightAlt, ModAlt, KeyAltRight},
+	{ModLeftShift, ModShift, KeyShiftLeft},
+	{ModRightShift, ModShift, KeyShiftRight},
+	{ModLeftSuper, ModSuper, KeySuperLeft},
+	{ModRightSuper, ModSuper, KeySuperRight},
+}
+
+// HasShift returns true if the Shift modifier is set.
+func (m Modifier) HasShift() bool { return m.Normalize()&ModShift != 0 }
+
+// HasCtrl returns true if the Ctrl modifier is set.
+func (m Modifier) HasCtrl() bool { return m.Normalize()&ModCtrl != 0 }
+
+// HasAlt returns true if the Alt modifier is set.
+func (m Modifier) HasAlt() bool { return m.Normalize()&ModAlt != 0 }
+
+// HasSuper returns true if the Super (Windows/Command) modifier is set.
+func (m Modifier) HasSuper() bool { return m.Normalize()&ModSuper != 0 }
+
+// HasCapsLock returns true if CapsLock is active.
+func (m Modifier) HasCapsLock() bool { return m&ModCapsLock != 0 }
+
+// HasNumLock returns true if NumLock is active.
+func (m Modifier) HasNumLock() bool { return m&ModNumLock != 0 }
+
+// Normalize returns m with the generic bit set for every side-specific bit,
+// so that ModRightAlt becomes ModAlt|ModRightAlt.
+func (m Modifier) Normalize() Modifier {
+	for _, s := range sideModifiers {
+		if m&s.side != 0 {
+			m |= s.generic
+		}
+	}
+	return m
+}
+
+// Generic returns m with side-specific bits folded into their generic bits
+// and then dropped, which is the form the C API and older code expect.
+func (m Modifier) Generic() Modifier {
+	return m.Normalize() &^ SideModifiers
+}
+
+// Keys returns the modifier keys a user would hold to produce m, in press
+// order: the key for each side-specific bit, the left-hand key for each
+// generic bit without a side-specific one. Lock bits and extended bits are
+// ignored; ModifierKeys.Keys adds the keys assigned to the latter.
+func (m Modifier) Keys() []Key {
+	var keys []Key
+	for i, s := range sideModifiers {
+		switch {
+		case m&s.side != 0:
+			keys = append(keys, s.key)
+		case i%2 == 0 && m&s.generic != 0 && m&s.generic.SideBits() == 0:
+			keys = append(keys, s.key)
+		}
+	}
+	return keys
+}
+
+// SideBits returns the side-specific bits of the generic bits set in m, so
+// that ModShift gives ModLeftShift|ModRightShift.
+func (m Modifier) SideBits() Modifier {
+	var bits Modifier
+	for _, s := range sideModifiers {
+		if s.generic&m != 0 {
+			bits |= s.side
+		}
+	}
+	return bits
+}
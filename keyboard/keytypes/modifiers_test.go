@@ -0,0 +1,26 @@
+package keytypes
+
+import "testing"
+
+func TestModifierSideBits(t *testing.T) {
+	tests := []struct {
+		in, want Modifier
+	}{
+		{0, 0},
+		{ModShift, ModLeftShift | ModRightShift},
+		{ModCtrl | ModSuper, ModLeftCtrl | ModRightCtrl | ModLeftSuper | ModRightSuper},
+		{ModAlt | ModLeftAlt, ModLeftAlt | ModRightAlt},
+		{ModCapsLock | ModAltGr, 0},
+		{NativeModifiers, SideModifiers},
+	}
+	for _, tt := range tests {
+		if got := tt.in.SideBits(); got != tt.want {
+			t.Errorf("%v.SideBits() = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	for side := ModLeftShift; side <= ModRightSuper; side <<= 1 {
+		if got := side.Generic().SideBits(); got&side == 0 {
+			t.Errorf("%v.SideBits() = %v, missing %v", side.Generic(), got, side)
+		}
+	}
+}
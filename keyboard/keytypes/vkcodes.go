@@ -0,0 +1,157 @@
+package keytypes
+
+// WindowsVK returns the Windows virtual-key code (a VK_* value from
+// winuser.h) for the key. The table is plain Go and works on every GOOS, so
+// bindings can be translated for Windows from any platform.
+//
+// KeyNumpadEnter has no code of its own: Windows reports it as VK_RETURN
+// with the extended-key flag. It returns false for that key and for keys
+// with no virtual-key code, such as the shifted symbols.
+func (k Key) WindowsVK() (uint16, bool) {
+	return vkTable.code(k)
+}
+
+// KeyFromWindowsVK returns the key for a Windows virtual-key code, or
+// KeyUnknown and false if the code has no Key. The side-less VK_SHIFT,
+// VK_CONTROL and VK_MENU map to the left-hand keys.
+func KeyFromWindowsVK(vk uint16) (Key, bool) {
+	return vkTable.key(vk)
+}
+
+var vkTable = newCodeTable([]codePair[uint16]{
+	{KeyBackspace, 0x08},
+	{KeyTab, 0x09},
+	{KeyEnter, 0x0D},
+	{KeyPause, 0x13},
+	{KeyCapsLock, 0x14},
+	{KeyHangul, 0x15},
+	{KeyHangulHanja, 0x19},
+	{KeyHenkan, 0x1C},
+	{KeyMuhenkan, 0x1D},
+	{KeyEscape, 0x1B},
+	{KeySpace, 0x20},
+	{KeyPageUp, 0x21},
+	{KeyPageDown, 0x22},
+	{KeyEnd, 0x23},
+	{KeyHome, 0x24},
+	{KeyLeft, 0x25},
+	{KeyUp, 0x26},
+	{KeyRight, 0x27},
+	{KeyDown, 0x28},
+	{KeyPrintScreen, 0x2C},
+	{KeyInsert, 0x2D},
+	{KeyDelete, 0x2E},
+	{KeyHelp, 0x2F},
+	{Key0, 0x30},
+	{Key1, 0x31},
+	{Key2, 0x32},
+	{Key3, 0x33},
+	{Key4, 0x34},
+	{Key5, 0x35},
+	{Key6, 0x36},
+	{Key7, 0x37},
+	{Key8, 0x38},
+	{Key9, 0x39},
+	{KeyA, 0x41},
+	{KeyB, 0x42},
+	{KeyC, 0x43},
+	{KeyD, 0x44},
+	{KeyE, 0x45},
+	{KeyF, 0x46},
+	{KeyG, 0x47},
+	{KeyH, 0x48},
+	{KeyI, 0x49},
+	{KeyJ, 0x4A},
+	{KeyK, 0x4B},
+	{KeyL, 0x4C},
+	{KeyM, 0x4D},
+	{KeyN, 0x4E},
+	{KeyO, 0x4F},
+	{KeyP, 0x50},
+	{KeyQ, 0x51},
+	{KeyR, 0x52},
+	{KeyS, 0x53},
+	{KeyT, 0x54},
+	{KeyU, 0x55},
+	{KeyV, 0x56},
+	{KeyW, 0x57},
+	{KeyX, 0x58},
+	{KeyY, 0x59},
+	{KeyZ, 0x5A},
+	{KeySuperLeft, 0x5B},
+	{KeySuperRight, 0x5C},
+	{KeyMenu, 0x5D},
+	{KeySleep, 0x5F},
+	{KeyNumpad0, 0x60},
+	{KeyNumpad1, 0x61},
+	{KeyNumpad2, 0x62},
+	{KeyNumpad3, 0x63},
+	{KeyNumpad4, 0x64},
+	{KeyNumpad5, 0x65},
+	{KeyNumpad6, 0x66},
+	{KeyNumpad7, 0x67},
+	{KeyNumpad8, 0x68},
+	{KeyNumpad9, 0x69},
+	{KeyNumpadMultiply, 0x6A},
+	{KeyNumpadPlus, 0x6B},
+	{KeyNumpadMinus, 0x6D},
+	{KeyNumpadDecimal, 0x6E},
+	{KeyNumpadDivide, 0x6F},
+	{KeyF1, 0x70},
+	{KeyF2, 0x71},
+	{KeyF3, 0x72},
+	{KeyF4, 0x73},
+	{KeyF5, 0x74},
+	{KeyF6, 0x75},
+	{KeyF7, 0x76},
+	{KeyF8, 0x77},
+	{KeyF9, 0x78},
+	{KeyF10, 0x79},
+	{KeyF11, 0x7A},
+	{KeyF12, 0x7B},
+	{KeyF13, 0x7C},
+	{KeyF14, 0x7D},
+	{KeyF15, 0x7E},
+	{KeyF16, 0x7F},
+	{KeyF17, 0x80},
+	{KeyF18, 0x81},
+	{KeyF19, 0x82},
+	{KeyF20, 0x83},
+	{KeyNumLock, 0x90},
+	{KeyScrollLock, 0x91},
+	{KeyShiftLeft, 0xA0},
+	{KeyShiftRight, 0xA1},
+	{KeyCtrlLeft, 0xA2},
+	{KeyCtrlRight, 0xA3},
+	{KeyAltLeft, 0xA4},
+	{KeyAltRight, 0xA5},
+	{KeyReload, 0xA8},
+	{KeySearch, 0xAA},
+	{KeyHomePage, 0xAC},
+	{KeyMute, 0xAD},
+	{KeyVolumeDown, 0xAE},
+	{KeyVolumeUp, 0xAF},
+	{KeyMediaNext, 0xB0},
+	{KeyMediaPrevious, 0xB1},
+	{KeyMediaStop, 0xB2},
+	{KeyMediaPlayPause, 0xB3},
+	{KeyMail, 0xB4},
+	{KeyExplorer, 0xB6},
+	{KeyCalculator, 0xB7},
+	{KeySemicolon, 0xBA},
+	{KeyEqual, 0xBB},
+	{KeyComma, 0xBC},
+	{KeyMinus, 0xBD},
+	{KeyPeriod, 0xBE},
+	{KeySlash, 0xBF},
+	{KeyGrave, 0xC0},
+	{KeyLeftBracket, 0xDB},
+	{KeyBackslash, 0xDC},
+	{KeyRightBracket, 0xDD},
+	{KeyApostrophe, 0xDE},
+
+	// Side-less modifier codes.
+	{KeyShiftLeft, 0x10},
+	{KeyCtrlLeft, 0x11},
+	{KeyAltLeft, 0x12},
+})
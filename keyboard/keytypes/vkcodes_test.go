@@ -0,0 +1,35 @@
+package keytypes
+
+import "testing"
+
+func TestWindowsVKCodes(t *testing.T) {
+	// Values from winuser.h.
+	checkCodes(t, "virtual-key code", []codeCase{
+		{KeyBackspace, 0x08},      // VK_BACK
+		{KeyTab, 0x09},            // VK_TAB
+		{KeyEnter, 0x0D},          // VK_RETURN
+		{KeyCapsLock, 0x14},       // VK_CAPITAL
+		{KeyEscape, 0x1B},         // VK_ESCAPE
+		{KeySpace, 0x20},          // VK_SPACE
+		{KeyLeft, 0x25},           // VK_LEFT
+		{KeyDelete, 0x2E},         // VK_DELETE
+		{Key0, 0x30},              // '0'
+		{KeyA, 0x41},              // 'A'
+		{KeySuperLeft, 0x5B},      // VK_LWIN
+		{KeyNumpad5, 0x65},        // VK_NUMPAD5
+		{KeyNumpadMultiply, 0x6A}, // VK_MULTIPLY
+		{KeyF1, 0x70},             // VK_F1
+		{KeyF13, 0x7C},            // VK_F13
+		{KeyShiftLeft, 0xA0},      // VK_LSHIFT
+		{KeyCtrlRight, 0xA3},      // VK_RCONTROL
+		{KeyAltRight, 0xA5},       // VK_RMENU
+		{KeyMute, 0xAD},           // VK_VOLUME_MUTE
+		{KeyVolumeUp, 0xAF},       // VK_VOLUME_UP
+	}, Key.WindowsVK, KeyFromWindowsVK)
+	checkCodeTable(t, "virtual-key code", vkTable, Key.WindowsVK, KeyFromWindowsVK)
+
+	// The side-neutral codes resolve to the left key.
+	if k, ok := KeyFromWindowsVK(0x10); !ok || k != KeyShiftLeft {
+		t.Errorf("KeyFromWindowsVK(VK_SHIFT) = %v, %v; want ShiftLeft, true", k, ok)
+	}
+}
@@ -12,80 +12,170 @@ static void keyboard_listener_callback_bridge(uint32_t codepoint, axidev_io_keyb
     goKeyboardListenerCallback(codepoint, key, mods, pressed, user_data);
 }
 
-static _Bool start_keyboard_listener_with_bridge(axidev_io_keyboard_listener_t listener, void* user_data) {
-    return axidev_io_keyboard_listener_start(listener, keyboard_listener_callback_bridge, user_data);
+static _Bool start_keyboard_listener_with_bridge(axidev_io_keyboard_listener_t listener, uintptr_t handle) {
+    return axidev_io_keyboard_listener_start(listener, keyboard_listener_callback_bridge, (void*)handle);
 }
 */
 import "C"
 
 import (
-	"errors"
 	"runtime/cgo"
+	"runtime/debug"
 	"sync"
-	"unsafe"
+	"sync/atomic"
+	"time"
 
 	axidevio "github.com/axide-dev/axidev-io-go"
 )
 
-// ListenerCallback is the function signature for keyboard event callbacks.
-// The callback may be invoked from an internal thread and must be thread-safe.
-type ListenerCallback func(event KeyEvent)
+// watchInterval is how often a running Listener checks that the native
+// listener is still alive.
+const watchInterval = 250 * time.Millisecond
 
 // Listener provides global keyboard event monitoring.
+//
+// A Listener is idle until Start, listens until Stop, Close or the native
+// listener ending on its own (for example when the device is removed or
+// permissions are revoked), and can then be started again. Done and Err
+// report when and why the current session ended.
 type Listener struct {
-	handle    C.axidev_io_keyboard_listener_t
-	mu        sync.Mutex
+	backend listenerBackend
+	mu      sync.Mutex
+	run     *listenerRun
+	dropped atomic.Uint64
+	stamper eventStamper
+
+	errHandler atomic.Pointer[func(error)]
+	errs       chan error
+	panicLimit atomic.Int64
+	panics     atomic.Int64
+}
+
+// listenerRun is one listening session, from Start until it ends. The
+// native callback receives a cgo.Handle to it, so a stale callback can never
+// observe a later session's callback.
+type listenerRun struct {
+	listener  *Listener
 	callback  ListenerCallback
 	cgoHandle cgo.Handle
+	paused    atomic.Bool
+	done      chan struct{}
+	err       error
+	ended     bool
 }
 
+// listenerBackend is the native listener a Listener drives. Tests replace
+// it to exercise the session logic without a keyboard.
+type listenerBackend interface {
+	// start starts delivering events to the callback bridge with handle as
+	// its user data, returning the native error message on failure.
+	start(handle cgo.Handle) (bool, string)
+	stop()
+	isListening() bool
+	destroy()
+}
+
+// nativeListener is the listenerBackend of the C API.
+type nativeListener struct {
+	handle C.axidev_io_keyboard_listener_t
+}
+
+func (n nativeListener) start(handle cgo.Handle) (bool, string) {
+	return axidevio.CallWithLastError(func() bool {
+		return bool(C.start_keyboard_listener_with_bridge(n.handle, C.uintptr_t(handle)))
+	})
+}
+
+func (n nativeListener) stop() { C.axidev_io_keyboard_listener_stop(n.handle) }
+
+func (n nativeListener) isListening() bool {
+	return bool(C.axidev_io_keyboard_listener_is_listening(n.handle))
+}
+
+func (n nativeListener) destroy() { C.axidev_io_keyboard_listener_destroy(n.handle) }
+
+// errorsBuffer is the capacity of the channel returned by Listener.Errors.
+const errorsBuffer = 16
+
+// closedChan is returned by Done before the first Start.
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
 // NewListener creates a new keyboard Listener instance.
 // Returns an error if allocation fails.
 func NewListener() (*Listener, error) {
-	handle := C.axidev_io_keyboard_listener_create()
-	if handle == nil {
-		return nil, errors.New("failed to create keyboard listener")
+	var handle C.axidev_io_keyboard_listener_t
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		handle = C.axidev_io_keyboard_listener_create()
+		return handle != nil
+	}); !ok {
+		return nil, &OpError{Op: "create listener", Message: msg, Err: classify(msg)}
+	}
+	return newListener(nativeListener{handle}), nil
+}
+
+// newListener creates a Listener driving backend.
+func newListener(backend listenerBackend) *Listener {
+	return &Listener{backend: backend, errs: make(chan error, errorsBuffer)}
+}
+
+var _ EventSource = (*Listener)(nil)
+
+// NewEventSource creates an EventSource backed by the native keyboard Listener.
+func NewEventSource() (EventSource, error) {
+	l, err := NewListener()
+	if err != nil {
+		return nil, err
 	}
-	return &Listener{handle: handle}, nil
+	return l, nil
 }
 
-// Close destroys the listener and releases resources.
+// Close stops the listener if running, destroys it and releases resources.
 // Safe to call multiple times.
 func (l *Listener) Close() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if l.handle != nil {
-		C.axidev_io_keyboard_listener_stop(l.handle)
-		C.axidev_io_keyboard_listener_destroy(l.handle)
-		l.handle = nil
-	}
-	if l.cgoHandle != 0 {
-		l.cgoHandle.Delete()
-		l.cgoHandle = 0
+	if l.backend != nil {
+		l.stopLocked(nil)
+		l.backend.destroy()
+		l.backend = nil
 	}
+	l.releaseRunLocked()
 }
 
 // Start begins listening for keyboard events.
 // The callback may be invoked from an internal thread.
 // The callback must be thread-safe and avoid long-blocking work.
+//
+// Start returns an error wrapping ErrAlreadyListening if the listener is
+// running; call Stop first to restart it with a different callback.
 func (l *Listener) Start(callback ListenerCallback) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if l.handle == nil {
-		return errors.New("listener is closed")
+	if l.backend == nil {
+		return &OpError{Op: "start", Err: ErrListenerClosed}
 	}
 	if callback == nil {
-		return errors.New("callback cannot be nil")
+		return &OpError{Op: "start", Err: ErrNilCallback}
 	}
+	if l.run != nil && !l.run.ended {
+		return &OpError{Op: "start", Err: ErrAlreadyListening}
+	}
+	l.releaseRunLocked()
+	l.stamper.reset()
 
-	l.callback = callback
-	l.cgoHandle = cgo.NewHandle(l)
-
-	if !C.start_keyboard_listener_with_bridge(l.handle, unsafe.Pointer(&l.cgoHandle)) {
-		l.cgoHandle.Delete()
-		l.cgoHandle = 0
-		return axidevio.GetLastErrorOrDefault("failed to start keyboard listener")
+	run := &listenerRun{listener: l, callback: callback, done: make(chan struct{})}
+	run.cgoHandle = cgo.NewHandle(run)
+	if ok, msg := l.backend.start(run.cgoHandle); !ok {
+		run.cgoHandle.Delete()
+		return &OpError{Op: "start", Message: msg, Err: classify(msg)}
 	}
+	l.run = run
+	l.panics.Store(0)
+	go l.watch(run)
 	return nil
 }
 
@@ -94,21 +184,202 @@ func (l *Listener) Start(callback ListenerCallback) error {
 func (l *Listener) Stop() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if l.handle != nil {
-		C.axidev_io_keyboard_listener_stop(l.handle)
-	}
-	if l.cgoHandle != 0 {
-		l.cgoHandle.Delete()
-		l.cgoHandle = 0
-	}
+	l.stopLocked(nil)
+	l.releaseRunLocked()
 }
 
-// IsListening returns true if the listener is currently active.
+// IsListening returns true if the listener is currently active, including
+// while paused.
 func (l *Listener) IsListening() bool {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if l.handle == nil {
+	if l.backend == nil || l.run == nil || l.run.ended {
 		return false
 	}
-	return bool(C.axidev_io_keyboard_listener_is_listening(l.handle))
+	return l.backend.isListening()
+}
+
+// Pause stops delivering events to the callback without tearing down the
+// native listener. Events that arrive while paused are discarded. No-op if
+// not running.
+func (l *Listener) Pause() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.run != nil && !l.run.ended {
+		l.run.paused.Store(true)
+	}
+}
+
+// Resume resumes delivering events after Pause.
+func (l *Listener) Resume() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.run != nil {
+		l.run.paused.Store(false)
+	}
+}
+
+// IsPaused returns true if the listener is running but paused.
+func (l *Listener) IsPaused() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.run != nil && !l.run.ended && l.run.paused.Load()
+}
+
+// Done returns a channel that is closed when the current listening session
+// ends, whether through Stop, Close or the native listener terminating. Before
+// the first Start it returns a closed channel.
+func (l *Listener) Done() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.run == nil {
+		return closedChan
+	}
+	return l.run.done
+}
+
+// Err returns why the most recent listening session ended: nil while it is
+// running or if it was ended by Stop or Close, otherwise an error wrapping
+// ErrListenerTerminated or ErrPanicLimit.
+func (l *Listener) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.run == nil {
+		return nil
+	}
+	return l.run.err
+}
+
+// stopLocked stops the native listener and ends the current session with
+// err. l.mu must be held.
+func (l *Listener) stopLocked(err error) {
+	if l.run == nil || l.run.ended {
+		return
+	}
+	if l.backend != nil {
+		l.backend.stop()
+	}
+	l.endLocked(l.run, err)
+}
+
+// endLocked marks run as ended with err and closes its done channel.
+// l.mu must be held; the caller reports err once it is released.
+func (l *Listener) endLocked(run *listenerRun, err error) {
+	if run.ended {
+		return
+	}
+	run.ended = true
+	run.err = err
+	close(run.done)
+}
+
+// releaseRunLocked frees the cgo handle of an ended session, once the native
+// listener can no longer call back with it. l.mu must be held.
+func (l *Listener) releaseRunLocked() {
+	if l.run != nil && l.run.ended && l.run.cgoHandle != 0 {
+		l.run.cgoHandle.Delete()
+		l.run.cgoHandle = 0
+	}
+}
+
+// stopRun stops the listener with err if run is still its current session.
+func (l *Listener) stopRun(run *listenerRun, err error) {
+	l.mu.Lock()
+	if l.run != run || run.ended {
+		l.mu.Unlock()
+		return
+	}
+	l.stopLocked(err)
+	l.releaseRunLocked()
+	l.mu.Unlock()
+	l.report(err)
+}
+
+// watch ends run with ErrListenerTerminated if the native listener stops on
+// its own.
+func (l *Listener) watch(run *listenerRun) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-run.done:
+			return
+		case <-ticker.C:
+		}
+		l.mu.Lock()
+		terminated := l.run == run && !run.ended && l.backend != nil &&
+			!l.backend.isListening()
+		if terminated {
+			l.stopLocked(&OpError{Op: "listen", Err: ErrListenerTerminated})
+		}
+		l.mu.Unlock()
+		if terminated {
+			l.report(run.err)
+			return
+		}
+	}
+}
+
+// SetErrorHandler sets a function called with errors that occur while
+// listening, such as a *PanicError recovered from the callback. It runs on
+// the listener thread and must not block; panics in the handler are
+// discarded. A nil handler removes it.
+func (l *Listener) SetErrorHandler(handler func(error)) {
+	if handler == nil {
+		l.errHandler.Store(nil)
+		return
+	}
+	l.errHandler.Store(&handler)
+}
+
+// Errors returns a channel that also receives errors that occur while
+// listening. Errors are dropped when the channel's buffer is full, so
+// reading it is optional.
+func (l *Listener) Errors() <-chan error {
+	return l.errs
+}
+
+// SetPanicLimit makes the listener stop itself after the callback panics n
+// times in a row, reporting ErrPanicLimit. A limit of 0 (the default) keeps
+// the listener running regardless of panics.
+func (l *Listener) SetPanicLimit(n int) {
+	l.panicLimit.Store(int64(max(n, 0)))
+}
+
+// SetModifierKeys assigns the keys whose events report the extended
+// modifiers: while an assigned key is held, events carry its extended bit
+// in Modifiers instead of its own bits. By default none is assigned.
+func (l *Listener) SetModifierKeys(mk ModifierKeys) {
+	l.stamper.setModifierKeys(mk)
+}
+
+// invoke runs the session's callback, recovering and reporting panics so
+// that they do not unwind into the native listener thread.
+func (l *Listener) invoke(run *listenerRun, event KeyEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			l.report(&PanicError{Value: r, Stack: debug.Stack(), Event: event})
+			if limit := l.panicLimit.Load(); limit > 0 && l.panics.Add(1) >= limit {
+				l.panics.Store(0)
+				// Stopping joins the listener thread, which is this one.
+				go l.stopRun(run, &OpError{Op: "listen", Err: ErrPanicLimit})
+			}
+		}
+	}()
+	run.callback(event)
+	l.panics.Store(0)
+}
+
+// report delivers err to the error handler and the Errors channel.
+func (l *Listener) report(err error) {
+	if h := l.errHandler.Load(); h != nil {
+		func() {
+			defer func() { _ = recover() }()
+			(*h)(err)
+		}()
+	}
+	select {
+	case l.errs <- err:
+	default:
+	}
 }
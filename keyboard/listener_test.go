@@ -0,0 +1,315 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"errors"
+	"runtime/cgo"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBackend stands in for the native listener. Its events are delivered
+// on the calling goroutine.
+type fakeBackend struct {
+	mu        sync.Mutex
+	handle    cgo.Handle
+	listening bool
+	startErr  string
+	destroyed bool
+}
+
+func (b *fakeBackend) start(handle cgo.Handle) (bool, string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.startErr != "" {
+		return false, b.startErr
+	}
+	b.handle, b.listening = handle, true
+	return true, ""
+}
+
+func (b *fakeBackend) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handle, b.listening = 0, false
+}
+
+func (b *fakeBackend) isListening() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.listening
+}
+
+func (b *fakeBackend) destroy() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.destroyed = true
+}
+
+// die makes the native listener stop on its own, as when the device goes
+// away.
+func (b *fakeBackend) die() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.listening = false
+}
+
+// send delivers a press of key through the callback bridge, if started.
+func (b *fakeBackend) send(key Key) {
+	b.mu.Lock()
+	handle := b.handle
+	b.mu.Unlock()
+	if handle != 0 {
+		handle.Value().(*listenerRun).deliver(KeyEvent{Key: key, Pressed: true, Time: time.Now()})
+	}
+}
+
+// keyRecorder is a listener callback that records the keys it receives.
+type keyRecorder struct {
+	mu   sync.Mutex
+	keys []Key
+}
+
+func (r *keyRecorder) callback(event KeyEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = append(r.keys, event.Key)
+}
+
+func (r *keyRecorder) got() []Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Key(nil), r.keys...)
+}
+
+// waitDone fails the test unless the listener's session ends in time.
+func waitDone(t *testing.T, l *Listener) {
+	t.Helper()
+	select {
+	case <-l.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("session did not end")
+	}
+}
+
+func TestListenerLifecycle(t *testing.T) {
+	b := &fakeBackend{}
+	l := newListener(b)
+	select {
+	case <-l.Done():
+	default:
+		t.Error("Done is open before Start")
+	}
+	if err := l.Start(nil); !errors.Is(err, ErrNilCallback) {
+		t.Errorf("Start(nil) = %v, want ErrNilCallback", err)
+	}
+
+	var first keyRecorder
+	if err := l.Start(first.callback); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Start(first.callback); !errors.Is(err, ErrAlreadyListening) {
+		t.Errorf("second Start = %v, want ErrAlreadyListening", err)
+	}
+	if !l.IsListening() {
+		t.Error("not listening after Start")
+	}
+	b.send(KeyA)
+	l.Stop()
+	waitDone(t, l)
+	if l.IsListening() || l.Err() != nil {
+		t.Errorf("after Stop: listening %v, Err %v; want false, nil", l.IsListening(), l.Err())
+	}
+
+	var second keyRecorder
+	if err := l.Start(second.callback); err != nil {
+		t.Fatalf("restart after Stop: %v", err)
+	}
+	b.send(KeyB)
+	if got := first.got(); len(got) != 1 || got[0] != KeyA {
+		t.Errorf("first session got %v, want [A]", got)
+	}
+	if got := second.got(); len(got) != 1 || got[0] != KeyB {
+		t.Errorf("second session got %v, want [B]", got)
+	}
+
+	l.Close()
+	l.Close()
+	waitDone(t, l)
+	if !b.destroyed {
+		t.Error("Close did not destroy the native listener")
+	}
+	if err := l.Start(second.callback); !errors.Is(err, ErrListenerClosed) {
+		t.Errorf("Start after Close = %v, want ErrListenerClosed", err)
+	}
+}
+
+func TestListenerStartFails(t *testing.T) {
+	b := &fakeBackend{startErr: "permission denied"}
+	l := newListener(b)
+	var oe *OpError
+	if err := l.Start(func(KeyEvent) {}); !errors.As(err, &oe) || oe.Message != b.startErr {
+		t.Fatalf("Start = %v, want an OpError with the native message", err)
+	}
+	if l.IsListening() {
+		t.Error("listening after a failed Start")
+	}
+	b.startErr = ""
+	if err := l.Start(func(KeyEvent) {}); err != nil {
+		t.Errorf("Start after a failed Start: %v", err)
+	}
+	l.Close()
+}
+
+func TestListenerPauseResume(t *testing.T) {
+	b := &fakeBackend{}
+	l := newListener(b)
+	defer l.Close()
+	var r keyRecorder
+	if err := l.Start(r.callback); err != nil {
+		t.Fatal(err)
+	}
+	l.Pause()
+	if !l.IsPaused() || !l.IsListening() {
+		t.Errorf("paused: IsPaused %v, IsListening %v; want true, true", l.IsPaused(), l.IsListening())
+	}
+	b.send(KeyA)
+	l.Resume()
+	b.send(KeyB)
+	if got := r.got(); len(got) != 1 || got[0] != KeyB {
+		t.Errorf("got %v, want [B]", got)
+	}
+	l.Stop()
+	l.Pause()
+	if l.IsPaused() {
+		t.Error("Pause took effect on a stopped listener")
+	}
+}
+
+func TestListenerTerminated(t *testing.T) {
+	b := &fakeBackend{}
+	l := newListener(b)
+	defer l.Close()
+	if err := l.Start(func(KeyEvent) {}); err != nil {
+		t.Fatal(err)
+	}
+	b.die()
+	waitDone(t, l)
+	if err := l.Err(); !errors.Is(err, ErrListenerTerminated) {
+		t.Errorf("Err = %v, want ErrListenerTerminated", err)
+	}
+	select {
+	case err := <-l.Errors():
+		if !errors.Is(err, ErrListenerTerminated) {
+			t.Errorf("Errors received %v, want ErrListenerTerminated", err)
+		}
+	default:
+		t.Error("termination was not sent to Errors")
+	}
+}
+
+func TestListenerCallbackPanic(t *testing.T) {
+	b := &fakeBackend{}
+	l := newListener(b)
+	defer l.Close()
+	var reported []error
+	l.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	var r keyRecorder
+	if err := l.Start(func(event KeyEvent) {
+		if event.Key == KeyA {
+			panic("boom")
+		}
+		r.callback(event)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	b.send(KeyA)
+	b.send(KeyB)
+
+	var pe *PanicError
+	if len(reported) != 1 || !errors.As(reported[0], &pe) || pe.Value != "boom" || pe.Event.Key != KeyA || len(pe.Stack) == 0 {
+		t.Fatalf("reported %v, want one PanicError for A", reported)
+	}
+	if got := r.got(); len(got) != 1 || got[0] != KeyB {
+		t.Errorf("callback got %v after the panic, want [B]", got)
+	}
+	if !l.IsListening() {
+		t.Error("listener stopped without a panic limit")
+	}
+}
+
+func TestListenerPanicLimit(t *testing.T) {
+	b := &fakeBackend{}
+	l := newListener(b)
+	defer l.Close()
+	l.SetPanicLimit(2)
+	if err := l.Start(func(event KeyEvent) {
+		if event.Key == KeyA {
+			panic("boom")
+		}
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// A callback that returns resets the count.
+	b.send(KeyA)
+	b.send(KeyB)
+	b.send(KeyA)
+	if !l.IsListening() {
+		t.Fatal("listener stopped before two panics in a row")
+	}
+	b.send(KeyA)
+	waitDone(t, l)
+	if err := l.Err(); !errors.Is(err, ErrPanicLimit) {
+		t.Errorf("Err = %v, want ErrPanicLimit", err)
+	}
+
+	// The limit error is reported after the session ends.
+	var panics int
+	for {
+		var err error
+		select {
+		case err = <-l.Errors():
+		case <-time.After(2 * time.Second):
+			t.Fatalf("no ErrPanicLimit on Errors after %d panics", panics)
+		}
+		var pe *PanicError
+		if !errors.As(err, &pe) {
+			if !errors.Is(err, ErrPanicLimit) {
+				t.Errorf("Errors received %v, want ErrPanicLimit", err)
+			}
+			break
+		}
+		panics++
+	}
+	if panics != 3 {
+		t.Errorf("Errors received %d panics before the limit, want 3", panics)
+	}
+}
+
+func TestListenerErrorsDropWhenFull(t *testing.T) {
+	b := &fakeBackend{}
+	l := newListener(b)
+	defer l.Close()
+	var handled int
+	l.SetErrorHandler(func(error) {
+		handled++
+		panic("handler panics are discarded")
+	})
+	if err := l.Start(func(KeyEvent) { panic("boom") }); err != nil {
+		t.Fatal(err)
+	}
+	for range errorsBuffer + 5 {
+		b.send(KeyA)
+	}
+	if n := len(l.Errors()); n != errorsBuffer {
+		t.Errorf("Errors holds %d, want %d", n, errorsBuffer)
+	}
+	if handled != errorsBuffer+5 {
+		t.Errorf("handler called %d times, want %d", handled, errorsBuffer+5)
+	}
+	if !l.IsListening() {
+		t.Error("listener stopped")
+	}
+}
@@ -1,41 +1,71 @@
 package keyboard
 
-/*
-#include <axidev-io/c_api.h>
-*/
-import "C"
+import "github.com/axide-dev/axidev-io-go/keyboard/keytypes"
 
 // Modifier represents a bitmask of keyboard modifier keys.
-type Modifier uint8
+//
+// The low byte holds the generic bits defined by the C API (ModShift,
+// ModCtrl, ...), which are set whichever side's key is held. The
+// side-specific bits (ModLeftShift, ModRightCtrl, ...) additionally say which
+// key it was. Code that only checks the generic bits keeps working unchanged.
+type Modifier = keytypes.Modifier
 
 // Modifier constants matching the C API.
 // These can be combined using bitwise OR:
 //
 //	mods := keyboard.ModCtrl | keyboard.ModShift
-//	sender.Combo(mods, keyboard.StringToKey("S"))  // Ctrl+Shift+S
+//	sender.Combo(mods, keyboard.KeyS)  // Ctrl+Shift+S
 const (
-	ModShift    Modifier = C.AXIDEV_IO_MOD_SHIFT
-	ModCtrl     Modifier = C.AXIDEV_IO_MOD_CTRL
-	ModAlt      Modifier = C.AXIDEV_IO_MOD_ALT
-	ModSuper    Modifier = C.AXIDEV_IO_MOD_SUPER
-	ModCapsLock Modifier = C.AXIDEV_IO_MOD_CAPSLOCK
-	ModNumLock  Modifier = C.AXIDEV_IO_MOD_NUMLOCK
+	ModShift    = keytypes.ModShift
+	ModCtrl     = keytypes.ModCtrl
+	ModAlt      = keytypes.ModAlt
+	ModSuper    = keytypes.ModSuper
+	ModCapsLock = keytypes.ModCapsLock
+	ModNumLock  = keytypes.ModNumLock
 )
 
-// HasShift returns true if the Shift modifier is set.
-func (m Modifier) HasShift() bool { return m&ModShift != 0 }
-
-// HasCtrl returns true if the Ctrl modifier is set.
-func (m Modifier) HasCtrl() bool { return m&ModCtrl != 0 }
+// Side-specific modifier bits. Listener events report them in
+// KeyEvent.Sides, separately from the generic bits, and HoldModifier,
+// ReleaseModifier and Combo accept them to press that side's key:
+//
+//	sender.Combo(keyboard.ModRightAlt, keyboard.KeyE) // AltGr+E
+const (
+	ModLeftShift  = keytypes.ModLeftShift
+	ModRightShift = keytypes.ModRightShift
+	ModLeftCtrl   = keytypes.ModLeftCtrl
+	ModRightCtrl  = keytypes.ModRightCtrl
+	ModLeftAlt    = keytypes.ModLeftAlt
+	ModRightAlt   = keytypes.ModRightAlt
+	ModLeftSuper  = keytypes.ModLeftSuper
+	ModRightSuper = keytypes.ModRightSuper
+)
 
-// HasAlt returns true if the Alt modifier is set.
-func (m Modifier) HasAlt() bool { return m&ModAlt != 0 }
+// Extended modifier bits for modifiers that have no key of their own. Each
+// is produced by the key assigned to it with SetModifierKeys on a Listener
+// or Sender; none is assigned by default. Listener events and
+// ActiveModifiers set the bit while its key is held, and HoldModifier,
+// ReleaseModifier and Combo press and release that key:
+//
+//	sender.SetModifierKeys(keyboard.ModifierKeys{AltGr: keyboard.KeyAltRight})
+//	sender.Combo(keyboard.ModAltGr, keyboard.KeyE) // € on many European layouts
+const (
+	ModAltGr = keytypes.ModAltGr
+	ModMeta  = keytypes.ModMeta
+	ModHyper = keytypes.ModHyper
+)
 
-// HasSuper returns true if the Super (Windows/Command) modifier is set.
-func (m Modifier) HasSuper() bool { return m&ModSuper != 0 }
+// Masks of the modifier bits by kind.
+const (
+	NativeModifiers   = keytypes.NativeModifiers
+	LockModifiers     = keytypes.LockModifiers
+	SideModifiers     = keytypes.SideModifiers
+	ExtendedModifiers = keytypes.ExtendedModifiers
+)
 
-// HasCapsLock returns true if CapsLock is active.
-func (m Modifier) HasCapsLock() bool { return m&ModCapsLock != 0 }
+// ModifierFromHID returns the modifiers set in a boot-protocol HID modifier
+// byte, with both the side-specific and the generic bit for each.
+func ModifierFromHID(b byte) Modifier { return keytypes.ModifierFromHID(b) }
 
-// HasNumLock returns true if NumLock is active.
-func (m Modifier) HasNumLock() bool { return m&ModNumLock != 0 }
+// ModifierKeys assigns the keys that produce the extended modifiers. The
+// zero value assigns none.
+type ModifierKeys = keytypes.ModifierKeys
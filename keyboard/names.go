@@ -0,0 +1,18 @@
+package keyboard
+
+/*
+#include <axidev-io/c_api.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import "unsafe"
+
+// nativeStringToKey parses name with the native parser. StringToKey never
+// calls it, answering from generated tables instead; tests use it to check
+// that the tables agree with the parser.
+func nativeStringToKey(name string) Key {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return Key(C.axidev_io_keyboard_string_to_key(cName))
+}
@@ -9,27 +9,62 @@ import "C"
 import (
 	"errors"
 	"sync"
+	"time"
 	"unsafe"
 
 	axidevio "github.com/axide-dev/axidev-io-go"
 )
 
+// The generic modifier bits are declared without cgo in keytypes; this
+// fails to compile if they drift from the C API.
+func _() {
+	var x [1]struct{}
+	_ = x[ModShift-C.AXIDEV_IO_MOD_SHIFT]
+	_ = x[ModCtrl-C.AXIDEV_IO_MOD_CTRL]
+	_ = x[ModAlt-C.AXIDEV_IO_MOD_ALT]
+	_ = x[ModSuper-C.AXIDEV_IO_MOD_SUPER]
+	_ = x[ModCapsLock-C.AXIDEV_IO_MOD_CAPSLOCK]
+	_ = x[ModNumLock-C.AXIDEV_IO_MOD_NUMLOCK]
+}
+
 // Sender provides keyboard input injection capabilities.
 type Sender struct {
-	handle C.axidev_io_keyboard_sender_t
-	mu     sync.Mutex
+	handle   C.axidev_io_keyboard_sender_t
+	mu       sync.Mutex
+	keyDelay time.Duration
+
+	// modKeys assigns the keys of the extended modifiers. sides tracks the
+	// side-specific modifier bits of modifier keys held through this Sender,
+	// and extended the extended bits of other held keys assigned in modKeys.
+	modKeys  ModifierKeys
+	sides    Modifier
+	extended Modifier
 }
 
 // NewSender creates a new keyboard Sender instance.
 // Returns an error if allocation fails.
 func NewSender() (*Sender, error) {
-	handle := C.axidev_io_keyboard_sender_create()
-	if handle == nil {
-		return nil, errors.New("failed to create keyboard sender")
+	var handle C.axidev_io_keyboard_sender_t
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		handle = C.axidev_io_keyboard_sender_create()
+		return handle != nil
+	}); !ok {
+		return nil, &OpError{Op: "create sender", Message: msg, Err: classify(msg)}
 	}
 	return &Sender{handle: handle}, nil
 }
 
+var _ Injector = (*Sender)(nil)
+
+// NewInjector creates an Injector backed by the native keyboard Sender.
+func NewInjector() (Injector, error) {
+	s, err := NewSender()
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // Close destroys the sender and releases resources.
 // Safe to call multiple times.
 func (s *Sender) Close() {
@@ -97,12 +132,9 @@ func (s *Sender) KeyDown(key Key) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "key down", Key: key, Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_key_down(s.handle, C.axidev_io_keyboard_key_t(key)) {
-		return axidevio.GetLastErrorOrDefault("key down failed")
-	}
-	return nil
+	return s.keyLocked("key down", key, true)
 }
 
 // KeyUp simulates a physical key release.
@@ -110,10 +142,32 @@ func (s *Sender) KeyUp(key Key) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "key up", Key: key, Err: ErrSenderClosed}
+	}
+	return s.keyLocked("key up", key, false)
+}
+
+// keyLocked presses or releases key, reporting failures as op. s.mu must
+// be held and the sender open.
+func (s *Sender) keyLocked(op string, key Key, down bool) error {
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		if down {
+			return bool(C.axidev_io_keyboard_sender_key_down(s.handle, C.axidev_io_keyboard_key_t(key)))
+		}
+		return bool(C.axidev_io_keyboard_sender_key_up(s.handle, C.axidev_io_keyboard_key_t(key)))
+	}); !ok {
+		return s.opError(op, key, 0, msg)
+	}
+	var extended Modifier
+	if key.SideModifierBit() == 0 {
+		extended = s.modKeys.Bits(key)
 	}
-	if !C.axidev_io_keyboard_sender_key_up(s.handle, C.axidev_io_keyboard_key_t(key)) {
-		return axidevio.GetLastErrorOrDefault("key up failed")
+	if down {
+		s.sides |= key.SideModifierBit()
+		s.extended |= extended
+	} else {
+		s.sides &^= key.SideModifierBit()
+		s.extended &^= extended
 	}
 	return nil
 }
@@ -123,48 +177,119 @@ func (s *Sender) Tap(key Key) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "tap", Key: key, Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_tap(s.handle, C.axidev_io_keyboard_key_t(key)) {
-		return axidevio.GetLastErrorOrDefault("tap failed")
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		return bool(C.axidev_io_keyboard_sender_tap(s.handle, C.axidev_io_keyboard_key_t(key)))
+	}); !ok {
+		return s.opError("tap", key, 0, msg)
 	}
 	return nil
 }
 
-// ActiveModifiers returns the currently active modifiers.
+// ActiveModifiers returns the currently active modifiers, including the
+// extended bits of keys held through this Sender. Side-specific bits are
+// reported by ActiveSides.
 func (s *Sender) ActiveModifiers() Modifier {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
 		return 0
 	}
-	return Modifier(C.axidev_io_keyboard_sender_active_modifiers(s.handle))
+	return s.activeModifiersLocked()
 }
 
-// HoldModifier presses the specified modifier keys.
+func (s *Sender) activeModifiersLocked() Modifier {
+	mods, _ := s.modKeys.Apply(Modifier(C.axidev_io_keyboard_sender_active_modifiers(s.handle))|s.sides.Generic(), s.sides)
+	return mods | s.extended
+}
+
+// ActiveSides returns the side-specific bits of the modifier keys held
+// through this Sender, except those of keys reported as an extended
+// modifier.
+func (s *Sender) ActiveSides() Modifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.activeSidesLocked()
+}
+
+func (s *Sender) activeSidesLocked() Modifier {
+	_, sides := s.modKeys.Apply(s.sides.Generic(), s.sides)
+	return sides
+}
+
+// unheldLocked returns mods, normalized, without the bits already active.
+// s.mu must be held and the sender open.
+func (s *Sender) unheldLocked(mods Modifier) Modifier {
+	return mods.Normalize() &^ (s.activeModifiersLocked() | s.activeSidesLocked())
+}
+
+// HoldModifier presses the specified modifier keys. A side-specific bit
+// such as ModRightCtrl presses that side's key; a generic bit such as
+// ModCtrl presses the backend's default key for it; an extended bit such as
+// ModAltGr presses the key assigned with SetModifierKeys, and fails with
+// ErrNoMapping if there is none.
 func (s *Sender) HoldModifier(mods Modifier) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "hold modifier", Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_hold_modifier(s.handle, C.axidev_io_keyboard_modifier_t(mods)) {
-		return axidevio.GetLastErrorOrDefault("hold modifier failed")
+	return s.holdLocked("hold modifier", mods)
+}
+
+// holdLocked implements HoldModifier. s.mu must be held and the sender open.
+func (s *Sender) holdLocked(op string, mods Modifier) error {
+	if s.modKeys.Unassigned(mods) != 0 {
+		return &OpError{Op: op, Backend: uint8(C.axidev_io_keyboard_sender_type(s.handle)), Err: ErrNoMapping}
+	}
+	if generic := (mods &^ SideModifiers &^ (mods & SideModifiers).Generic()) & NativeModifiers; generic != 0 {
+		if ok, msg := axidevio.CallWithLastError(func() bool {
+			return bool(C.axidev_io_keyboard_sender_hold_modifier(s.handle, C.axidev_io_keyboard_modifier_t(generic)))
+		}); !ok {
+			return s.opError(op, 0, 0, msg)
+		}
+	}
+	for _, key := range s.modKeys.Keys(mods & (SideModifiers | ExtendedModifiers)) {
+		if err := s.keyLocked(op, key, true); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-// ReleaseModifier releases the specified modifier keys.
+// ReleaseModifier releases the specified modifier keys. A side-specific bit
+// releases that side's key; a generic bit releases the modifier on both
+// sides; an extended bit releases its assigned key.
 func (s *Sender) ReleaseModifier(mods Modifier) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "release modifier", Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_release_modifier(s.handle, C.axidev_io_keyboard_modifier_t(mods)) {
-		return axidevio.GetLastErrorOrDefault("release modifier failed")
+	return s.releaseLocked("release modifier", mods)
+}
+
+// releaseLocked implements ReleaseModifier. s.mu must be held and the
+// sender open.
+func (s *Sender) releaseLocked(op string, mods Modifier) error {
+	sides := mods & SideModifiers
+	generic := mods &^ SideModifiers &^ sides.Generic()
+	// A generic bit also releases side keys held for it.
+	sides |= s.sides & generic.SideBits()
+
+	var errs []error
+	for _, key := range s.modKeys.Keys(sides | mods&ExtendedModifiers) {
+		errs = append(errs, s.keyLocked(op, key, false))
 	}
-	return nil
+	if generic&NativeModifiers != 0 {
+		if ok, msg := axidevio.CallWithLastError(func() bool {
+			return bool(C.axidev_io_keyboard_sender_release_modifier(s.handle, C.axidev_io_keyboard_modifier_t(generic&NativeModifiers)))
+		}); !ok {
+			errs = append(errs, s.opError(op, 0, 0, msg))
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // ReleaseAllModifiers releases all currently held modifiers.
@@ -172,38 +297,70 @@ func (s *Sender) ReleaseAllModifiers() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "release all modifiers", Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_release_all_modifiers(s.handle) {
-		return axidevio.GetLastErrorOrDefault("release all modifiers failed")
+	var errs []error
+	for _, key := range s.modKeys.Keys(s.sides | s.extended) {
+		errs = append(errs, s.keyLocked("release all modifiers", key, false))
 	}
-	return nil
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		return bool(C.axidev_io_keyboard_sender_release_all_modifiers(s.handle))
+	}); !ok {
+		errs = append(errs, s.opError("release all modifiers", 0, 0, msg))
+	}
+	s.sides = 0
+	s.extended = 0
+	return errors.Join(errs...)
 }
 
 // Combo executes a key combo: press modifiers, tap key, release modifiers.
+// Side-specific and extended bits in mods press their keys, as for
+// HoldModifier; modifiers already active then are neither pressed nor
+// released, so a modifier held with HoldModifier stays held.
 func (s *Sender) Combo(mods Modifier, key Key) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "combo", Key: key, Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_combo(s.handle, C.axidev_io_keyboard_modifier_t(mods), C.axidev_io_keyboard_key_t(key)) {
-		return axidevio.GetLastErrorOrDefault("combo failed")
+	if mods&(SideModifiers|ExtendedModifiers) != 0 {
+		pressed := s.unheldLocked(mods)
+		if err := s.holdLocked("combo", pressed); err != nil {
+			return errors.Join(err, s.releaseLocked("combo", pressed))
+		}
+		err := s.keyLocked("combo", key, true)
+		if err == nil {
+			err = s.keyLocked("combo", key, false)
+		}
+		return errors.Join(err, s.releaseLocked("combo", pressed))
+	}
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		return bool(C.axidev_io_keyboard_sender_combo(s.handle, C.axidev_io_keyboard_modifier_t(mods&NativeModifiers), C.axidev_io_keyboard_key_t(key)))
+	}); !ok {
+		return s.opError("combo", key, 0, msg)
 	}
 	return nil
 }
 
+// SendChord holds the chord's modifiers, taps its key and releases the
+// modifiers, like Combo.
+func (s *Sender) SendChord(c Chord) error {
+	return s.Combo(c.Mods, c.Key)
+}
+
 // TypeText injects UTF-8 text directly (layout-independent on supporting backends).
 func (s *Sender) TypeText(text string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "type text", Err: ErrSenderClosed}
 	}
 	cText := C.CString(text)
 	defer C.free(unsafe.Pointer(cText))
-	if !C.axidev_io_keyboard_sender_type_text_utf8(s.handle, cText) {
-		return axidevio.GetLastErrorOrDefault("type text failed")
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		return bool(C.axidev_io_keyboard_sender_type_text_utf8(s.handle, cText))
+	}); !ok {
+		return s.opError("type text", 0, 0, msg)
 	}
 	return nil
 }
@@ -213,10 +370,12 @@ func (s *Sender) TypeCharacter(codepoint rune) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.handle == nil {
-		return errors.New("sender is closed")
+		return &OpError{Op: "type character", Codepoint: codepoint, Err: ErrSenderClosed}
 	}
-	if !C.axidev_io_keyboard_sender_type_character(s.handle, C.uint32_t(codepoint)) {
-		return axidevio.GetLastErrorOrDefault("type character failed")
+	if ok, msg := axidevio.CallWithLastError(func() bool {
+		return bool(C.axidev_io_keyboard_sender_type_character(s.handle, C.uint32_t(codepoint)))
+	}); !ok {
+		return s.opError("type character", 0, codepoint, msg)
 	}
 	return nil
 }
@@ -230,11 +389,56 @@ func (s *Sender) Flush() {
 	}
 }
 
-// SetKeyDelay sets the delay (in microseconds) used by tap/combo operations.
+// SetKeyDelay sets the delay (in microseconds) used by tap/combo operations,
+// including the Context variants, which wait it out between events.
 func (s *Sender) SetKeyDelay(delayMicroseconds uint32) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.keyDelay = time.Duration(delayMicroseconds) * time.Microsecond
 	if s.handle != nil {
 		C.axidev_io_keyboard_sender_set_key_delay(s.handle, C.uint32_t(delayMicroseconds))
 	}
 }
+
+// SetModifierKeys assigns the keys that HoldModifier, ReleaseModifier and
+// Combo press for the extended modifiers, and that ActiveModifiers reports
+// as them. By default none is assigned.
+func (s *Sender) SetModifierKeys(mk ModifierKeys) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.modKeys = mk
+}
+
+// opError builds the error for a failed native call, classifying the native
+// error message and, when it is not specific, the backend state. s.mu must be
+// held and s.handle must be valid.
+func (s *Sender) opError(op string, key Key, codepoint rune, msg string) error {
+	err := &OpError{
+		Op:        op,
+		Key:       key,
+		Codepoint: codepoint,
+		Backend:   uint8(C.axidev_io_keyboard_sender_type(s.handle)),
+		Message:   msg,
+		Err:       classify(msg),
+	}
+	if err.Err != ErrFailed {
+		return err
+	}
+
+	var caps C.axidev_io_keyboard_capabilities_t
+	C.axidev_io_keyboard_sender_get_capabilities(s.handle, &caps)
+	switch {
+	case !bool(C.axidev_io_keyboard_sender_is_ready(s.handle)) &&
+		(bool(caps.needs_accessibility_perm) || bool(caps.needs_input_monitoring_perm) || bool(caps.needs_uinput_access)):
+		err.Err = ErrPermission
+	case codepoint != 0 || op == "type text":
+		if !bool(caps.can_inject_text) {
+			err.Err = ErrUnsupported
+		} else if codepoint != 0 {
+			err.Err = ErrNoMapping
+		}
+	case !bool(caps.can_inject_keys):
+		err.Err = ErrUnsupported
+	}
+	return err
+}
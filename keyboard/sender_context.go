@@ -0,0 +1,163 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// TypeTextContext injects text one character at a time, checking ctx before
+// each character and waiting the key delay (see SetKeyDelay) between them.
+//
+// Each rune is injected with TypeCharacter rather than the text being passed
+// to TypeText, so every rune behaves as it would for TypeCharacter: a
+// combining sequence is sent as its separate runes, and a rune with no
+// mapping stops the text with ErrNoMapping.
+//
+// It returns the number of characters (runes) delivered. If ctx is done
+// before the text is complete, the returned *OpError wraps ctx.Err() and
+// names the first undelivered character.
+func (s *Sender) TypeTextContext(ctx context.Context, text string) (int, error) {
+	return typeTextContext(ctx, s, text)
+}
+
+// contextSender is the part of a Sender that the Context methods drive, so
+// that their steps and cancellation can be exercised without a native
+// backend.
+type contextSender interface {
+	KeyDown(key Key) error
+	KeyUp(key Key) error
+	TypeCharacter(codepoint rune) error
+	ReleaseModifier(mods Modifier) error
+	holdUnheld(op string, mods Modifier) (Modifier, error)
+	delay() time.Duration
+}
+
+func typeTextContext(ctx context.Context, s contextSender, text string) (int, error) {
+	n := 0
+	for _, r := range text {
+		if n > 0 {
+			if err := wait(ctx, s); err != nil {
+				return n, &OpError{Op: "type text", Codepoint: r, Err: err}
+			}
+		} else if err := ctx.Err(); err != nil {
+			return n, &OpError{Op: "type text", Codepoint: r, Err: err}
+		}
+		if err := s.TypeCharacter(r); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// TapContext presses and releases key, waiting the key delay in between.
+// If ctx is done while the key is down, the key is released before
+// returning an *OpError that wraps ctx.Err().
+func (s *Sender) TapContext(ctx context.Context, key Key) error {
+	return tapContext(ctx, s, key)
+}
+
+func tapContext(ctx context.Context, s contextSender, key Key) error {
+	if err := ctx.Err(); err != nil {
+		return &OpError{Op: "tap", Key: key, Err: err}
+	}
+	if err := s.KeyDown(key); err != nil {
+		return err
+	}
+	waitErr := wait(ctx, s)
+	if err := s.KeyUp(key); err != nil {
+		return errors.Join(ctxOpError("tap", key, waitErr), err)
+	}
+	return ctxOpError("tap", key, waitErr)
+}
+
+// ComboContext holds mods, taps key and releases mods, waiting the key delay
+// between each step. If ctx is done part-way through, everything pressed so
+// far is released before returning an *OpError that wraps ctx.Err().
+//
+// Modifiers already active when it is called are neither pressed nor
+// released, so a modifier held with HoldModifier stays held.
+func (s *Sender) ComboContext(ctx context.Context, mods Modifier, key Key) error {
+	return comboContext(ctx, s, mods, key)
+}
+
+func comboContext(ctx context.Context, s contextSender, mods Modifier, key Key) error {
+	if err := ctx.Err(); err != nil {
+		return &OpError{Op: "combo", Key: key, Err: err}
+	}
+	mods, err := s.holdUnheld("combo", mods)
+	if err != nil {
+		return err
+	}
+	release := func(cause error, keyDown bool) error {
+		var errs []error
+		if keyDown {
+			errs = append(errs, s.KeyUp(key))
+		}
+		errs = append(errs, s.ReleaseModifier(mods))
+		return errors.Join(append([]error{ctxOpError("combo", key, cause)}, errs...)...)
+	}
+
+	if err := wait(ctx, s); err != nil {
+		return release(err, false)
+	}
+	if err := s.KeyDown(key); err != nil {
+		return errors.Join(err, s.ReleaseModifier(mods))
+	}
+	if err := wait(ctx, s); err != nil {
+		return release(err, true)
+	}
+	if err := s.KeyUp(key); err != nil {
+		return errors.Join(err, s.ReleaseModifier(mods))
+	}
+	if err := wait(ctx, s); err != nil {
+		return release(err, false)
+	}
+	return s.ReleaseModifier(mods)
+}
+
+// holdUnheld holds the bits of mods that are not already active and
+// returns them, so that the caller releases only what it pressed.
+func (s *Sender) holdUnheld(op string, mods Modifier) (Modifier, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.handle == nil {
+		return 0, &OpError{Op: op, Err: ErrSenderClosed}
+	}
+	mods = s.unheldLocked(mods)
+	return mods, s.holdLocked(op, mods)
+}
+
+func (s *Sender) delay() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.keyDelay
+}
+
+// wait sleeps for the key delay of s or until ctx is done, returning
+// ctx.Err() in the latter case.
+func wait(ctx context.Context, s contextSender) error {
+	d := s.delay()
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// ctxOpError wraps a context error for op, or returns nil if err is nil.
+func ctxOpError(op string, key Key, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &OpError{Op: op, Key: key, Err: err}
+}
@@ -0,0 +1,106 @@
+//go:build cgo
+
+package keyboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// stepSender records the steps the Context methods take, holding modifiers
+// the way Sender does, and cancels cancel once it takes step cancelAt.
+type stepSender struct {
+	held     Modifier
+	keyDelay time.Duration
+	steps    []string
+	cancelAt string
+	cancel   context.CancelFunc
+}
+
+func (s *stepSender) step(format string, args ...any) error {
+	step := fmt.Sprintf(format, args...)
+	s.steps = append(s.steps, step)
+	if step == s.cancelAt {
+		s.cancel()
+	}
+	return nil
+}
+
+func (s *stepSender) KeyDown(key Key) error              { return s.step("down %v", key) }
+func (s *stepSender) KeyUp(key Key) error                { return s.step("up %v", key) }
+func (s *stepSender) TypeCharacter(codepoint rune) error { return s.step("type %c", codepoint) }
+func (s *stepSender) delay() time.Duration               { return s.keyDelay }
+
+func (s *stepSender) ReleaseModifier(mods Modifier) error {
+	s.held &^= mods
+	return s.step("release %v", mods)
+}
+
+func (s *stepSender) holdUnheld(op string, mods Modifier) (Modifier, error) {
+	mods = mods.Normalize() &^ s.held
+	s.held |= mods
+	return mods, s.step("hold %v", mods)
+}
+
+func TestTypeTextContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &stepSender{cancelAt: "type b", cancel: cancel}
+
+	n, err := typeTextContext(ctx, s, "abc")
+	if n != 2 {
+		t.Errorf("n = %d, want 2", n)
+	}
+	var oe *OpError
+	if !errors.As(err, &oe) || oe.Codepoint != 'c' || !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want OpError for 'c' wrapping context.Canceled", err)
+	}
+	if want := []string{"type a", "type b"}; !slices.Equal(s.steps, want) {
+		t.Errorf("steps = %q, want %q", s.steps, want)
+	}
+}
+
+func TestComboContextKeepsHeldModifiers(t *testing.T) {
+	held := ModLeftShift.Normalize()
+	s := &stepSender{held: held}
+
+	if err := comboContext(context.Background(), s, ModLeftShift|ModCtrl, KeyA); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		fmt.Sprintf("hold %v", ModCtrl),
+		fmt.Sprintf("down %v", KeyA),
+		fmt.Sprintf("up %v", KeyA),
+		fmt.Sprintf("release %v", ModCtrl),
+	}
+	if !slices.Equal(s.steps, want) {
+		t.Errorf("steps = %q, want %q", s.steps, want)
+	}
+	if s.held != held {
+		t.Errorf("held afterwards = %v, want %v", s.held, held)
+	}
+}
+
+func TestComboContextCancelledReleases(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &stepSender{keyDelay: time.Millisecond, cancelAt: fmt.Sprintf("down %v", KeyA), cancel: cancel}
+
+	err := comboContext(ctx, s, ModCtrl, KeyA)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	want := []string{
+		fmt.Sprintf("hold %v", ModCtrl),
+		fmt.Sprintf("down %v", KeyA),
+		fmt.Sprintf("up %v", KeyA),
+		fmt.Sprintf("release %v", ModCtrl),
+	}
+	if !slices.Equal(s.steps, want) || s.held != 0 {
+		t.Errorf("steps = %q, held = %v; want %q, None", s.steps, s.held, want)
+	}
+}